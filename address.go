@@ -1,10 +1,63 @@
 package faker
 
 import (
-	"math/rand"
 	"reflect"
+	"strings"
 )
 
+// country is a country name with its ISO 3166-1 alpha-2 and alpha-3 codes
+type country struct {
+	name   string
+	alpha2 string
+	alpha3 string
+}
+
+// Countries | Source: https://en.wikipedia.org/wiki/ISO_3166-1
+var countries = []country{
+	{"Argentina", "AR", "ARG"}, {"Australia", "AU", "AUS"}, {"Austria", "AT", "AUT"}, {"Belgium", "BE", "BEL"},
+	{"Brazil", "BR", "BRA"}, {"Canada", "CA", "CAN"}, {"Chile", "CL", "CHL"}, {"China", "CN", "CHN"},
+	{"Colombia", "CO", "COL"}, {"Czechia", "CZ", "CZE"}, {"Denmark", "DK", "DNK"}, {"Egypt", "EG", "EGY"},
+	{"Finland", "FI", "FIN"}, {"France", "FR", "FRA"}, {"Germany", "DE", "DEU"}, {"Greece", "GR", "GRC"},
+	{"Hungary", "HU", "HUN"}, {"India", "IN", "IND"}, {"Indonesia", "ID", "IDN"}, {"Ireland", "IE", "IRL"},
+	{"Israel", "IL", "ISR"}, {"Italy", "IT", "ITA"}, {"Japan", "JP", "JPN"}, {"Kenya", "KE", "KEN"},
+	{"Mexico", "MX", "MEX"}, {"Morocco", "MA", "MAR"}, {"Netherlands", "NL", "NLD"}, {"New Zealand", "NZ", "NZL"},
+	{"Nigeria", "NG", "NGA"}, {"Norway", "NO", "NOR"}, {"Peru", "PE", "PER"}, {"Philippines", "PH", "PHL"},
+	{"Poland", "PL", "POL"}, {"Portugal", "PT", "PRT"}, {"Romania", "RO", "ROU"}, {"Saudi Arabia", "SA", "SAU"},
+	{"Singapore", "SG", "SGP"}, {"South Africa", "ZA", "ZAF"}, {"South Korea", "KR", "KOR"}, {"Spain", "ES", "ESP"},
+	{"Sweden", "SE", "SWE"}, {"Switzerland", "CH", "CHE"}, {"Thailand", "TH", "THA"}, {"Turkey", "TR", "TUR"},
+	{"Ukraine", "UA", "UKR"}, {"United Arab Emirates", "AE", "ARE"}, {"United Kingdom", "GB", "GBR"},
+	{"United States", "US", "USA"}, {"Vietnam", "VN", "VNM"},
+}
+
+// place is a city of a locale with its region, the format of its postcodes and its coordinates
+type place struct {
+	city      string
+	state     string
+	stateCode string
+	postcode  string
+	latitude  float64
+	longitude float64
+}
+
+// RealAddress is a postal address of the locale of the generation whose parts agree with each other:
+// the postcode, state and coordinates are the ones of the city, and Formatted is made of the other parts.
+type RealAddress struct {
+	StreetAddress     string
+	StreetName        string
+	BuildingNumber    string
+	SecondaryUnit     string
+	City              string
+	State             string
+	StateAbbr         string
+	Postcode          string
+	Country           string
+	CountryCode       string
+	CountryCodeAlpha3 string
+	Formatted         string
+	Latitude          float64
+	Longitude         float64
+}
+
 var address Addresser
 
 // GetAddress returns a new Addresser interface of Address
@@ -20,6 +73,9 @@ func GetAddress() Addresser {
 
 // SetAddress sets custom Address
 func SetAddress(net Addresser) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	address = net
 }
 
@@ -30,41 +86,41 @@ type Addresser interface {
 }
 
 // Address struct
-type Address struct{}
-
-func (i Address) latitude() float32 {
-	return (rand.Float32() * 180) - 90
+type Address struct {
+	provider
 }
 
-// Latitude sets latitude of the address
+// Latitude sets latitude of the address, in the area set with the near and radius_km or bbox tag parameters, see LatLng
 func (i Address) Latitude(v reflect.Value) (interface{}, error) {
-	kind := v.Kind()
-	val := i.latitude()
-	if kind == reflect.Float32 {
-		return val, nil
+	area, err := i.area()
+	if err != nil {
+		return nil, err
 	}
-	return float64(val), nil
-}
-
-func (i Address) longitude() float32 {
-	return (rand.Float32() * 360) - 180
+	val := area.point(i.rnd()).Latitude
+	if v.Kind() == reflect.Float32 {
+		return float32(val), nil
+	}
+	return val, nil
 }
 
-// Longitude sets longitude of the address
+// Longitude sets longitude of the address, in the area set with the near and radius_km or bbox tag parameters, see LatLng
 func (i Address) Longitude(v reflect.Value) (interface{}, error) {
-	kind := v.Kind()
-	val := i.longitude()
-	if kind == reflect.Float32 {
-		return val, nil
+	area, err := i.area()
+	if err != nil {
+		return nil, err
+	}
+	val := area.point(i.rnd()).Longitude
+	if v.Kind() == reflect.Float32 {
+		return float32(val), nil
 	}
-	return float64(val), nil
+	return val, nil
 }
 
 // Longitude get fake longitude randomly
 func Longitude() float64 {
 	return singleFakeData(LONGITUDE, func() interface{} {
 		address := Address{}
-		return float64(address.longitude())
+		return address.localeArea().point(address.rnd()).Longitude
 	}).(float64)
 }
 
@@ -72,6 +128,245 @@ func Longitude() float64 {
 func Latitude() float64 {
 	return singleFakeData(LATITUDE, func() interface{} {
 		address := Address{}
-		return float64(address.latitude())
+		return address.localeArea().point(address.rnd()).Latitude
 	}).(float64)
 }
+
+// places returns the cities of the locale of the generation, the ones of the default locale when it has none
+func (i Address) places() (*locale, []place) {
+	if l := i.locale(); len(l.places) > 0 {
+		return l, l.places
+	}
+	l := locales[DefaultLocale]
+	return l, l.places
+}
+
+func (i Address) place() place {
+	_, places := i.places()
+	return places[i.rnd().Intn(len(places))]
+}
+
+func (i Address) streetName() string {
+	return randomElementFromSliceString(i.rnd(), i.dataset(streetNamesDataset))
+}
+
+// StreetName returns the name of a street, e.g. "Oak Avenue"
+func (i Address) StreetName(v reflect.Value) (interface{}, error) {
+	return i.streetName(), nil
+}
+
+// StreetName get fake street name
+func StreetName() string {
+	return singleFakeData(StreetNameTag, func() interface{} {
+		a := Address{}
+		return a.streetName()
+	}).(string)
+}
+
+func (i Address) buildingNumber() string {
+	return i.digits(randomElementFromSliceString(i.rnd(), i.dataset(buildingNumberFormatsDataset)))
+}
+
+// BuildingNumber returns the number of a building in a street, e.g. "742"
+func (i Address) BuildingNumber(v reflect.Value) (interface{}, error) {
+	return i.buildingNumber(), nil
+}
+
+// BuildingNumber get fake building number
+func BuildingNumber() string {
+	return singleFakeData(BuildingNumberTag, func() interface{} {
+		a := Address{}
+		return a.buildingNumber()
+	}).(string)
+}
+
+func (i Address) secondaryAddress() string {
+	return i.digits(randomElementFromSliceString(i.rnd(), i.dataset(secondaryAddressFormatsDataset)))
+}
+
+// SecondaryAddress returns a secondary unit of a building, e.g. "Apt. 12"
+func (i Address) SecondaryAddress(v reflect.Value) (interface{}, error) {
+	return i.secondaryAddress(), nil
+}
+
+// SecondaryAddress get fake secondary address
+func SecondaryAddress() string {
+	return singleFakeData(SecondaryAddressTag, func() interface{} {
+		a := Address{}
+		return a.secondaryAddress()
+	}).(string)
+}
+
+// streetAddress formats the street and building number in the way of the locale
+func (i Address) streetAddress(street, building string) string {
+	l, _ := i.places()
+	return strings.NewReplacer("{street_name}", street, "{building_number}", building).Replace(l.streetFormat)
+}
+
+// StreetAddress returns a building number and a street, e.g. "742 Oak Avenue"
+func (i Address) StreetAddress(v reflect.Value) (interface{}, error) {
+	return i.streetAddress(i.streetName(), i.buildingNumber()), nil
+}
+
+// StreetAddress get fake street address
+func StreetAddress() string {
+	return singleFakeData(StreetAddressTag, func() interface{} {
+		a := Address{}
+		return a.streetAddress(a.streetName(), a.buildingNumber())
+	}).(string)
+}
+
+// City returns the name of a city
+func (i Address) City(v reflect.Value) (interface{}, error) {
+	return i.place().city, nil
+}
+
+// City get fake city
+func City() string {
+	return singleFakeData(CityTag, func() interface{} {
+		a := Address{}
+		return a.place().city
+	}).(string)
+}
+
+// State returns the name of a state or region
+func (i Address) State(v reflect.Value) (interface{}, error) {
+	return i.place().state, nil
+}
+
+// State get fake state
+func State() string {
+	return singleFakeData(StateTag, func() interface{} {
+		a := Address{}
+		return a.place().state
+	}).(string)
+}
+
+// StateAbbr returns the code of a state or region, e.g. "CA"
+func (i Address) StateAbbr(v reflect.Value) (interface{}, error) {
+	return i.place().stateCode, nil
+}
+
+// StateAbbr get fake state abbreviation
+func StateAbbr() string {
+	return singleFakeData(StateAbbrTag, func() interface{} {
+		a := Address{}
+		return a.place().stateCode
+	}).(string)
+}
+
+// Postcode returns a postal code
+func (i Address) Postcode(v reflect.Value) (interface{}, error) {
+	return i.digits(i.place().postcode), nil
+}
+
+// Postcode get fake postcode
+func Postcode() string {
+	return singleFakeData(PostcodeTag, func() interface{} {
+		a := Address{}
+		return a.digits(a.place().postcode)
+	}).(string)
+}
+
+func (i Address) country() country {
+	return countries[i.rnd().Intn(len(countries))]
+}
+
+// Country returns the English name of a country, drawn from all the countries and not only the one of the locale
+func (i Address) Country(v reflect.Value) (interface{}, error) {
+	return i.country().name, nil
+}
+
+// Country get fake country
+func Country() string {
+	return singleFakeData(CountryTag, func() interface{} {
+		a := Address{}
+		return a.country().name
+	}).(string)
+}
+
+// CountryCode returns the ISO 3166-1 alpha-2 code of a country, e.g. "DE"
+func (i Address) CountryCode(v reflect.Value) (interface{}, error) {
+	return i.country().alpha2, nil
+}
+
+// CountryCode get fake country code
+func CountryCode() string {
+	return singleFakeData(CountryCodeTag, func() interface{} {
+		a := Address{}
+		return a.country().alpha2
+	}).(string)
+}
+
+// CountryCodeAlpha3 returns the ISO 3166-1 alpha-3 code of a country, e.g. "DEU"
+func (i Address) CountryCodeAlpha3(v reflect.Value) (interface{}, error) {
+	return i.country().alpha3, nil
+}
+
+// CountryCodeAlpha3 get fake country code alpha-3
+func CountryCodeAlpha3() string {
+	return singleFakeData(CountryCodeAlpha3Tag, func() interface{} {
+		a := Address{}
+		return a.country().alpha3
+	}).(string)
+}
+
+func (i Address) realAddress() RealAddress {
+	l, places := i.places()
+	p := places[i.rnd().Intn(len(places))]
+	a := RealAddress{
+		StreetName:        i.streetName(),
+		BuildingNumber:    i.buildingNumber(),
+		City:              p.city,
+		State:             p.state,
+		StateAbbr:         p.stateCode,
+		Postcode:          i.digits(p.postcode),
+		Country:           l.country.name,
+		CountryCode:       l.country.alpha2,
+		CountryCodeAlpha3: l.country.alpha3,
+		// within about 5km of the city center
+		Latitude:  p.latitude + (i.rnd().Float64()-0.5)*0.1,
+		Longitude: p.longitude + (i.rnd().Float64()-0.5)*0.1,
+	}
+	a.StreetAddress = i.streetAddress(a.StreetName, a.BuildingNumber)
+	street := a.StreetAddress
+	if i.rnd().Intn(3) == 0 {
+		a.SecondaryUnit = i.secondaryAddress()
+		street += " " + a.SecondaryUnit
+	}
+	a.Formatted = strings.NewReplacer(
+		"{street_address}", street,
+		"{city}", a.City,
+		"{state}", a.State,
+		"{state_code}", a.StateAbbr,
+		"{postcode}", a.Postcode,
+		"{country}", a.Country,
+	).Replace(l.addressFormat)
+	return a
+}
+
+// FormattedAddress returns a multi-line postal address formatted in the way of the locale
+func (i Address) FormattedAddress(v reflect.Value) (interface{}, error) {
+	return i.realAddress().Formatted, nil
+}
+
+// FormattedAddress get fake formatted address
+func FormattedAddress() string {
+	return singleFakeData(FormattedAddressTag, func() interface{} {
+		a := Address{}
+		return a.realAddress().Formatted
+	}).(string)
+}
+
+// RealAddress returns a RealAddress, whose parts agree with each other
+func (i Address) RealAddress(v reflect.Value) (interface{}, error) {
+	return i.realAddress(), nil
+}
+
+// FakeRealAddress get fake RealAddress
+func FakeRealAddress() RealAddress {
+	return singleFakeData(RealAddressTag, func() interface{} {
+		a := Address{}
+		return a.realAddress()
+	}).(RealAddress)
+}
@@ -1,6 +1,8 @@
 package faker
 
 import (
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -21,3 +23,98 @@ func TestGetLatitude(t *testing.T) {
 		t.Error("function Latitude need return a valid longitude")
 	}
 }
+
+func TestAddressParts(t *testing.T) {
+	var a struct {
+		StreetAddress     string `faker:"street_address"`
+		StreetName        string `faker:"street_name"`
+		BuildingNumber    string `faker:"building_number"`
+		SecondaryAddress  string `faker:"secondary_address"`
+		City              string `faker:"city"`
+		State             string `faker:"state"`
+		StateAbbr         string `faker:"state_abbr"`
+		Postcode          string `faker:"postcode"`
+		Country           string `faker:"country"`
+		CountryCode       string `faker:"country_code"`
+		CountryCodeAlpha3 string `faker:"country_code_alpha3"`
+		Formatted         string `faker:"formatted_address"`
+	}
+	if err := FakeData(&a); err != nil {
+		t.Fatal(err)
+	}
+	if !contains(streetNames(), a.StreetName) || !strings.Contains(a.StreetAddress, " ") {
+		t.Errorf("expected a street address, but got %q and %q", a.StreetName, a.StreetAddress)
+	}
+	if a.BuildingNumber == "" || a.BuildingNumber[0] == '0' || strings.ContainsAny(a.BuildingNumber+a.SecondaryAddress+a.Postcode, "#%") {
+		t.Errorf("expected formatted numbers, but got %q, %q and %q", a.BuildingNumber, a.SecondaryAddress, a.Postcode)
+	}
+	if a.City == "" || a.State == "" || len(a.StateAbbr) != 2 || len(a.Postcode) != 5 {
+		t.Errorf("expected a US city, but got %+v", a)
+	}
+	if len(a.CountryCode) != 2 || len(a.CountryCodeAlpha3) != 3 || a.Country == "" {
+		t.Errorf("expected a country, but got %q, %q and %q", a.Country, a.CountryCode, a.CountryCodeAlpha3)
+	}
+	if lines := strings.Split(a.Formatted, "\n"); len(lines) != 3 || lines[2] != "United States" {
+		t.Errorf("expected a formatted US address, but got %q", a.Formatted)
+	}
+}
+
+func streetNames() []string {
+	return locales[DefaultLocale].datasets[streetNamesDataset]
+}
+
+func TestRealAddress(t *testing.T) {
+	for _, name := range Locales() {
+		l := locales[name]
+		for i := 0; i < 20; i++ {
+			var r struct {
+				Home RealAddress  `faker:"real_address"`
+				Work *RealAddress `faker:"real_address"`
+			}
+			if err := FakeData(&r, WithLocale(name)); err != nil {
+				t.Fatal(err)
+			}
+			if r.Work == nil {
+				t.Fatal("expected a pointer to a RealAddress")
+			}
+			a := r.Home
+			var p *place
+			for j := range l.places {
+				if l.places[j].city == a.City {
+					p = &l.places[j]
+				}
+			}
+			if p == nil || p.state != a.State || p.stateCode != a.StateAbbr || len(p.postcode) != len(a.Postcode) {
+				t.Fatalf("%s: expected the parts of a city, but got %+v", name, a)
+			}
+			for k := range p.postcode {
+				if p.postcode[k] != '#' && p.postcode[k] != a.Postcode[k] {
+					t.Fatalf("%s: expected a postcode of %s, but got %s", name, a.City, a.Postcode)
+				}
+			}
+			if a.CountryCode != l.country.alpha2 || a.CountryCodeAlpha3 != l.country.alpha3 || a.Country != l.country.name {
+				t.Errorf("%s: expected the country of the locale, but got %+v", name, a)
+			}
+			for _, part := range []string{a.StreetName, a.BuildingNumber, a.SecondaryUnit, a.City, a.Postcode, a.Country} {
+				if !strings.Contains(a.Formatted, part) {
+					t.Errorf("%s: expected %q in %q", name, part, a.Formatted)
+				}
+			}
+			if math.Abs(a.Latitude-p.latitude) > 0.05 || math.Abs(a.Longitude-p.longitude) > 0.05 {
+				t.Errorf("%s: expected coordinates near %s, but got %f,%f", name, a.City, a.Latitude, a.Longitude)
+			}
+		}
+	}
+	if a := FakeRealAddress(); a.CountryCode != "US" {
+		t.Errorf("expected a US address, but got %+v", a)
+	}
+}
+
+func TestAddressSingleFakeData(t *testing.T) {
+	for _, fn := range []func() string{StreetName, BuildingNumber, SecondaryAddress, StreetAddress, City, State, StateAbbr,
+		Postcode, Country, CountryCode, CountryCodeAlpha3, FormattedAddress} {
+		if v := fn(); v == "" {
+			t.Error("expected a value")
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package faker
+
+import (
+	"time"
+)
+
+// clock is the function set with SetClock, guarded by settingsMu
+var clock = time.Now
+
+// SetClock sets the function the providers get the current time from, time.Now by default, so that the times relative
+// to now, e.g. the past and future tag parameters, the birthdates and the card expiry dates, can be fixed in tests:
+//
+//	faker.SetClock(func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) })
+//
+// A nil now restores time.Now.
+func SetClock(now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
+	clock = now
+}
+
+// WithClock makes the call get the current time from now instead of the clock set with SetClock
+func WithClock(now func() time.Time) Option {
+	return func(o *options) {
+		o.clock = now
+	}
+}
+
+// currentTime returns the current time of the generation, from its clock or else the one set with SetClock
+func (p provider) currentTime() time.Time {
+	if p.clock != nil {
+		return p.clock()
+	}
+	settingsMu.RLock()
+	now := clock
+	settingsMu.RUnlock()
+
+	return now()
+}
@@ -0,0 +1,76 @@
+package faker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	now := time.Date(2030, 6, 15, 12, 0, 0, 0, time.UTC)
+	SetClock(func() time.Time { return now })
+	defer SetClock(nil)
+
+	var r struct {
+		Past     time.Time `faker:"past,within=1y"`
+		Future   int64     `faker:"unix_time,future,within=1d"`
+		UnixTime int64     `faker:"unix_time"`
+		Expiry   string    `faker:"cc_expiry"`
+	}
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		if r.Past.After(now) || r.Past.Before(now.AddDate(-1, 0, 0)) {
+			t.Errorf("expected a time in the year before %v, but got %v", now, r.Past)
+		}
+		if r.Future < now.Unix() || r.Future > now.Add(24*time.Hour).Unix() {
+			t.Errorf("expected a time in the day after %v, but got %v", now, time.Unix(r.Future, 0))
+		}
+		if r.UnixTime >= now.Unix() {
+			t.Errorf("expected a time before %v, but got %v", now, time.Unix(r.UnixTime, 0))
+		}
+		if expiry, err := time.Parse("01/06", r.Expiry); err != nil || !expiry.After(now) {
+			t.Errorf("expected an expiry date after %v, but got %s", now, r.Expiry)
+		}
+	}
+
+	// the clock of the call takes precedence
+	then := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := FakeData(&r, WithClock(func() time.Time { return then })); err != nil {
+		t.Fatal(err)
+	}
+	if expiry, _ := time.Parse("01/06", r.Expiry); r.Past.After(then) || expiry.Year() > 1995 {
+		t.Errorf("expected times relative to %v, but got %v and %s", then, r.Past, r.Expiry)
+	}
+}
+
+func TestClockBeforeEpoch(t *testing.T) {
+	now := time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := WithClock(func() time.Time { return now })
+	var r struct {
+		Date      string    `faker:"date"`
+		Time      time.Time `faker:"time"`
+		UnixTime  int64     `faker:"unix_time"`
+		Timestamp string    `faker:"timestamp"`
+		Year      string    `faker:"year"`
+		Past      time.Time `faker:"past"`
+		Birthdate time.Time `faker:"birthdate"`
+		Age       int       `faker:"age,from=Birthdate"`
+		Expiry    string    `faker:"cc_expiry"`
+		Untagged  time.Time
+	}
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&r, clock); err != nil {
+			t.Fatal(err)
+		}
+		if r.UnixTime >= now.Unix() || r.UnixTime < now.Add(-defaultTimeSpan).Unix() {
+			t.Errorf("expected a time in the years before %v, but got %v", now, time.Unix(r.UnixTime, 0))
+		}
+		if r.Time.After(now) || r.Past.After(now) || r.Date >= "1960-01-01" {
+			t.Errorf("expected times before %v, but got %v, %v and %s", now, r.Time, r.Past, r.Date)
+		}
+		if r.Age < defaultMinAge || r.Age > defaultMaxAge {
+			t.Errorf("expected an adult born before %v, but got %v", now, r.Birthdate)
+		}
+	}
+}
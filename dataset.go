@@ -0,0 +1,212 @@
+package faker
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Formats of the datasets read with ReadDataset
+const (
+	DatasetJSON = "json"
+	DatasetYAML = "yaml"
+	DatasetCSV  = "csv"
+)
+
+// DatasetOption customizes how a dataset is stored by SetDataset, ReadDataset and LoadDataset
+type DatasetOption func(*datasetOptions)
+
+type datasetOptions struct {
+	locale string
+	append bool
+	column string
+}
+
+// ForLocale stores the dataset in the given locale, en_US by default.
+// The datasets of en_US are used by the other locales when they lack them.
+func ForLocale(name string) DatasetOption {
+	return func(o *datasetOptions) {
+		o.locale = name
+	}
+}
+
+// AppendValues adds the values to the dataset instead of replacing it
+func AppendValues() DatasetOption {
+	return func(o *datasetOptions) {
+		o.append = true
+	}
+}
+
+// CSVColumn reads the values of a CSV dataset from the column with the given name in its header row,
+// instead of the first column of every row
+func CSVColumn(name string) DatasetOption {
+	return func(o *datasetOptions) {
+		o.column = name
+	}
+}
+
+// SetDataset replaces the values of the dataset called name, which the providers draw from, e.g.
+//
+//	faker.SetDataset("words", []string{"widget", "gadget", "gizmo"})
+//
+// The datasets of the built-in providers are: titles_male, titles_female, first_names, first_names_male,
+// first_names_female, last_names, words, currencies, tlds, street_names, phone_formats, toll_free_formats,
+// e164_formats, building_number_formats and secondary_address_formats, in which # stands for a random digit
+// and % for a random non zero digit. Setting first_names_male or first_names_female also sets first_names
+// to both of them. Datasets of any other name are drawn from with the dataset tag, e.g. `faker:"dataset=product_names"`.
+func SetDataset(name string, values []string, opt ...DatasetOption) error {
+	opts := &datasetOptions{locale: DefaultLocale}
+	for _, o := range opt {
+		o(opts)
+	}
+	if len(values) == 0 {
+		return fmt.Errorf(ErrEmptyDataset, name)
+	}
+	l, err := findLocale(opts.locale)
+	if err != nil {
+		return err
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	if opts.append {
+		values = append(append([]string{}, l.datasets[name]...), values...)
+	} else {
+		values = append([]string{}, values...)
+	}
+	l.datasets[name] = values
+	if name == firstNamesMaleDataset || name == firstNamesFemaleDataset {
+		l.datasets[firstNamesDataset] = append(append([]string{}, l.datasets[firstNamesMaleDataset]...), l.datasets[firstNamesFemaleDataset]...)
+	}
+	return nil
+}
+
+// ReadDataset sets the dataset called name to the values read from r, see SetDataset.
+// format is one of DatasetJSON, an array of strings, DatasetYAML, a sequence of strings,
+// or DatasetCSV, whose values are the first column of each row unless CSVColumn is used.
+func ReadDataset(name string, r io.Reader, format string, opt ...DatasetOption) error {
+	opts := &datasetOptions{}
+	for _, o := range opt {
+		o(opts)
+	}
+
+	var values []string
+	var err error
+	switch format {
+	case DatasetJSON:
+		err = json.NewDecoder(r).Decode(&values)
+	case DatasetYAML:
+		values, err = readYAMLDataset(name, r)
+	case DatasetCSV:
+		values, err = readCSVDataset(name, r, opts.column)
+	default:
+		return fmt.Errorf(ErrUnknownDatasetFormat, format)
+	}
+	if err != nil {
+		return err
+	}
+	return SetDataset(name, values, opt...)
+}
+
+// datasetValue returns a random value of the dataset named with the dataset tag, e.g. `faker:"dataset=product_names"`
+func (p provider) datasetValue(v reflect.Value) (interface{}, error) {
+	name := p.params[DatasetTag]
+	values := p.dataset(name)
+	if len(values) == 0 {
+		return nil, fmt.Errorf(ErrUnknownDataset, name)
+	}
+	return randomElementFromSliceString(p.rnd(), values), nil
+}
+
+// datasetFormat returns the format of a dataset file from its extension
+func datasetFormat(file string) (string, error) {
+	switch strings.ToLower(path.Ext(file)) {
+	case ".json":
+		return DatasetJSON, nil
+	case ".yaml", ".yml":
+		return DatasetYAML, nil
+	case ".csv":
+		return DatasetCSV, nil
+	}
+	return "", fmt.Errorf(ErrUnknownDatasetFormat, file)
+}
+
+// readYAMLDataset reads a YAML sequence of scalars, one per line
+func readYAMLDataset(name string, r io.Reader) ([]string, error) {
+	var values []string
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text == "---" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if text != "-" && !strings.HasPrefix(text, "- ") {
+			return nil, fmt.Errorf(ErrWrongDatasetLine, name, line)
+		}
+		value := strings.TrimSpace(strings.TrimPrefix(text, "-"))
+		switch {
+		case strings.HasPrefix(value, `"`):
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf(ErrWrongDatasetLine, name, line)
+			}
+			value = unquoted
+		case strings.HasPrefix(value, "'"):
+			if len(value) < 2 || !strings.HasSuffix(value, "'") {
+				return nil, fmt.Errorf(ErrWrongDatasetLine, name, line)
+			}
+			value = strings.Replace(value[1:len(value)-1], "''", "'", -1)
+		default:
+			if i := strings.Index(value, " #"); i >= 0 {
+				value = strings.TrimSpace(value[:i])
+			}
+		}
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values, scanner.Err()
+}
+
+// readCSVDataset reads the first column of each row, or the given column of the rows following the header
+func readCSVDataset(name string, r io.Reader, column string) ([]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	index := 0
+	if column != "" {
+		index = -1
+		if len(records) > 0 {
+			for i, field := range records[0] {
+				if strings.TrimSpace(field) == column {
+					index = i
+				}
+			}
+			records = records[1:]
+		}
+		if index < 0 {
+			return nil, fmt.Errorf(ErrDatasetColumnNotFound, name, column)
+		}
+	}
+
+	var values []string
+	for _, record := range records {
+		if index < len(record) {
+			if value := strings.TrimSpace(record[index]); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,27 @@
+//go:build go1.16
+// +build go1.16
+
+package faker
+
+import (
+	"io/fs"
+)
+
+// LoadDataset sets the dataset called name to the values of file in fsys, e.g.
+//
+//	faker.LoadDataset("first_names", os.DirFS("testdata"), "names.csv")
+//
+// The format of the file is given by its extension: .json, .yaml, .yml or .csv, see ReadDataset and SetDataset.
+func LoadDataset(name string, fsys fs.FS, file string, opt ...DatasetOption) error {
+	format, err := datasetFormat(file)
+	if err != nil {
+		return err
+	}
+	f, err := fsys.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return ReadDataset(name, f, format, opt...)
+}
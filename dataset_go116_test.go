@@ -0,0 +1,38 @@
+//go:build go1.16
+// +build go1.16
+
+package faker
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadDataset(t *testing.T) {
+	defer saveDatasets()()
+
+	fsys := fstest.MapFS{
+		"names.csv":  {Data: []byte("Ada\nGrace\n")},
+		"names.txt":  {Data: []byte("Ada\n")},
+		"codes.yaml": {Data: []byte("- HR-01\n- IT-02\n")},
+	}
+	if err := LoadDataset("first_names", fsys, "names.csv"); err != nil {
+		t.Fatal(err)
+	}
+	if name := FirstName(); name != "Ada" && name != "Grace" {
+		t.Errorf("expected a name of the file, but got %s", name)
+	}
+	if err := LoadDataset("words", fsys, "codes.yaml", AppendValues()); err != nil {
+		t.Fatal(err)
+	}
+	if words := (provider{}).dataset(wordsDataset); words[len(words)-1] != "IT-02" {
+		t.Errorf("expected the codes appended to the words, but got %v", words[len(words)-2:])
+	}
+
+	if err := LoadDataset("first_names", fsys, "names.txt"); err == nil {
+		t.Error("expected an unknown format error")
+	}
+	if err := LoadDataset("first_names", fsys, "missing.csv"); err == nil {
+		t.Error("expected a missing file error")
+	}
+}
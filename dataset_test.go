@@ -0,0 +1,160 @@
+package faker
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// saveDatasets returns a func restoring the datasets of all the locales
+func saveDatasets() func() {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	saved := map[string]map[string][]string{}
+	for name, l := range locales {
+		saved[name] = map[string][]string{}
+		for dataset, values := range l.datasets {
+			saved[name][dataset] = values
+		}
+	}
+	return func() {
+		localesMu.Lock()
+		defer localesMu.Unlock()
+
+		for name, datasets := range saved {
+			locales[name].datasets = datasets
+		}
+	}
+}
+
+func TestSetDataset(t *testing.T) {
+	defer saveDatasets()()
+
+	words := []string{"widget", "gadget", "gizmo"}
+	if err := SetDataset("words", words); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if w := Word(); !contains(words, w) {
+			t.Errorf("expected a word of the dataset, but got %s", w)
+		}
+	}
+	var r struct {
+		Word string `faker:"word"`
+	}
+	if err := FakeData(&r, WithLocale("fr_FR")); err != nil {
+		t.Fatal(err)
+	}
+	if contains(words, r.Word) {
+		t.Errorf("expected a word of fr_FR, but got %s", r.Word)
+	}
+
+	if err := SetDataset("tlds", []string{"example"}, AppendValues()); err != nil {
+		t.Fatal(err)
+	}
+	if tlds := (provider{}).dataset(tldsDataset); len(tlds) != len(tld)+1 || tlds[len(tld)] != "example" {
+		t.Errorf("expected example appended to the tlds, but got %v", tlds)
+	}
+
+	if err := SetDataset("first_names_female", []string{"Ada"}, ForLocale("de")); err != nil {
+		t.Fatal(err)
+	}
+	if names := locales["de_DE"].datasets[firstNamesDataset]; names[len(names)-1] != "Ada" {
+		t.Errorf("expected first_names to include the female names, but got %v", names)
+	}
+}
+
+func TestSetDatasetErrors(t *testing.T) {
+	if err := SetDataset("words", nil); err == nil || err.Error() != fmt.Sprintf(ErrEmptyDataset, "words") {
+		t.Errorf("expected an empty dataset error, but got %v", err)
+	}
+	if err := SetDataset("words", []string{"widget"}, ForLocale("xx")); err == nil {
+		t.Error("expected an unknown locale error")
+	}
+	if err := ReadDataset("words", strings.NewReader(""), "xml"); err == nil || err.Error() != fmt.Sprintf(ErrUnknownDatasetFormat, "xml") {
+		t.Errorf("expected an unknown format error, but got %v", err)
+	}
+}
+
+func TestDatasetTag(t *testing.T) {
+	defer saveDatasets()()
+
+	products := []string{"widget", "gadget", "gizmo"}
+	if err := SetDataset("product_names", products); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetDataset("product_names", []string{"bidule"}, ForLocale("fr_FR")); err != nil {
+		t.Fatal(err)
+	}
+	var r struct {
+		Name    string  `faker:"dataset=product_names"`
+		Pointer *string `faker:"dataset=product_names"`
+	}
+	for i := 0; i < 10; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		if !contains(products, r.Name) || !contains(products, *r.Pointer) {
+			t.Errorf("expected products of the dataset, but got %s and %s", r.Name, *r.Pointer)
+		}
+	}
+	if err := FakeData(&r, WithLocale("fr_FR")); err != nil {
+		t.Fatal(err)
+	}
+	if r.Name != "bidule" {
+		t.Errorf("expected the product of fr_FR, but got %s", r.Name)
+	}
+
+	var unknown struct {
+		Name string `faker:"dataset=colors"`
+	}
+	if err := FakeData(&unknown); err == nil || err.Error() != fmt.Sprintf(ErrUnknownDataset, "colors") {
+		t.Errorf("expected an unknown dataset error, but got %v", err)
+	}
+
+	type Product struct {
+		Name string `faker:"dataset=product_names,unique"`
+	}
+	defer ResetUnique()
+	for range products {
+		if err := FakeData(&Product{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := FakeData(&Product{}); err == nil || err.Error() != fmt.Sprintf(ErrUniqueExhausted, 3, "Name") {
+		t.Errorf("expected the products to be exhausted, but got %v", err)
+	}
+}
+
+func TestReadDataset(t *testing.T) {
+	defer saveDatasets()()
+
+	cases := []struct {
+		format string
+		input  string
+		opt    []DatasetOption
+		values []string
+	}{
+		{DatasetJSON, `["EUR", "USD"]`, nil, []string{"EUR", "USD"}},
+		{DatasetYAML, "---\n# currencies\n- EUR\n- \"US D\"\n- 'it''s' \n- CHF # swiss\n", nil, []string{"EUR", "US D", "it's", "CHF"}},
+		{DatasetCSV, "EUR,euro\nUSD,dollar\n", nil, []string{"EUR", "USD"}},
+		{DatasetCSV, "code,name\nEUR,euro\nUSD,dollar\n", []DatasetOption{CSVColumn("name")}, []string{"euro", "dollar"}},
+	}
+	for _, c := range cases {
+		if err := ReadDataset("currencies", strings.NewReader(c.input), c.format, c.opt...); err != nil {
+			t.Fatal(err)
+		}
+		if values := locales[DefaultLocale].datasets[currenciesDataset]; !reflect.DeepEqual(values, c.values) {
+			t.Errorf("%s: expected %v, but got %v", c.format, c.values, values)
+		}
+	}
+
+	if err := ReadDataset("currencies", strings.NewReader("EUR\n"), DatasetYAML); err == nil || err.Error() != fmt.Sprintf(ErrWrongDatasetLine, "currencies", 1) {
+		t.Errorf("expected an invalid line error, but got %v", err)
+	}
+	if err := ReadDataset("currencies", strings.NewReader("code\nEUR\n"), DatasetCSV, CSVColumn("name")); err == nil || err.Error() != fmt.Sprintf(ErrDatasetColumnNotFound, "currencies", "name") {
+		t.Errorf("expected a column not found error, but got %v", err)
+	}
+}
@@ -1,9 +1,12 @@
 package faker
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"reflect"
+	"strconv"
 	"time"
 )
 
@@ -635,159 +638,329 @@ func GetDateTimer() DateTimer {
 
 // SetDateTimer sets custom date time
 func SetDateTimer(d DateTimer) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	date = d
 }
 
 // DateTime struct
 type DateTime struct {
+	provider
+}
+
+// defaultTimeSpan is the length of the time ranges bounded on one side only, or set with past or future alone
+const defaultTimeSpan = 10 * 365 * 24 * time.Hour
+
+// dateLayouts are the layouts of the after and before tag parameters
+var dateLayouts = []string{BaseDateFormat, time.RFC3339, BaseDateFormat + " " + TimeFormat, BaseDateFormat + "T" + TimeFormat}
+
+// timeRange returns the bounds set with the after, before, within, past and future tag parameters, e.g.
+// `faker:"date,after=2020-01-01,before=2024-12-31"` or `faker:"unix_time,past,within=30d"`.
+// The after and before dates are written like 2006-01-02, 2006-01-02 15:04:05 or in RFC 3339.
+// within is a number of days, weeks or years, e.g. 30d, 2w or 1y, or a time.Duration, e.g. 36h,
+// which bounds the range from the other bound, or from now on both sides when it is used alone.
+// ok is false when none of them is set.
+func (d DateTime) timeRange() (from, to time.Time, ok bool, err error) {
+	after, hasAfter := d.param(After)
+	before, hasBefore := d.param(BeforeParam)
+	within, hasWithin := d.param(WithinParam)
+	_, past := d.param(PastParam)
+	_, future := d.param(FutureParam)
+	if !hasAfter && !hasBefore && !hasWithin && !past && !future {
+		return from, to, false, nil
+	}
+	if past && (future || hasBefore) || future && hasAfter {
+		return from, to, false, fmt.Errorf(ErrWrongFormattedTag, "past and future cannot be used with each other or another bound")
+	}
+
+	span := defaultTimeSpan
+	if hasWithin {
+		if span, ok = parseSpan(within); !ok {
+			return from, to, false, fmt.Errorf(ErrWrongFormattedTag, WithinParam+Equals+within)
+		}
+	}
+	now := d.currentTime()
+	if hasAfter {
+		if from, ok = parseDate(after); !ok {
+			return from, to, false, fmt.Errorf(ErrWrongFormattedTag, After+Equals+after)
+		}
+	}
+	if hasBefore {
+		if to, ok = parseDate(before); !ok {
+			return from, to, false, fmt.Errorf(ErrWrongFormattedTag, BeforeParam+Equals+before)
+		}
+	}
+	if past {
+		to, hasBefore = now, true
+	}
+	if future {
+		from, hasAfter = now, true
+	}
+	switch {
+	case hasAfter && !hasBefore:
+		to = from.Add(span)
+	case !hasAfter && hasBefore:
+		from = to.Add(-span)
+	case !hasAfter && !hasBefore:
+		from, to = now.Add(-span), now.Add(span)
+	}
+	if to.Before(from) {
+		return from, to, false, errors.New(ErrStartValueBiggerThanEnd)
+	}
+	return from, to, true, nil
 }
 
-func (d DateTime) unixtime() int64 {
-	return RandomUnixTime()
+func parseDate(s string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
 }
 
-// UnixTime get unix time
-func (d DateTime) UnixTime(v reflect.Value) (interface{}, error) {
-	kind := v.Kind()
-	var val int64
-	if kind == reflect.Int64 {
-		val = d.unixtime()
+// parseSpan parses a positive number of days, weeks or years, e.g. 30d, or a time.Duration
+func parseSpan(s string) (time.Duration, bool) {
+	units := map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour, "y": 365 * 24 * time.Hour}
+	if s == "" {
+		return 0, false
+	}
+	var span time.Duration
+	if unit, ok := units[s[len(s)-1:]]; ok && len(s) > 1 {
+		n, err := strconv.ParseFloat(s[:len(s)-1], 64)
+		if err != nil || n > float64(math.MaxInt64/unit) {
+			return 0, false
+		}
+		span = time.Duration(n * float64(unit))
 	} else {
-		val = 0
+		var err error
+		if span, err = time.ParseDuration(s); err != nil {
+			return 0, false
+		}
 	}
+	return span, span > 0
+}
 
-	return val, nil
+// hasTimeRange tells whether one of the time range tag parameters is set, see timeRange
+func (d DateTime) hasTimeRange() bool {
+	for _, name := range []string{After, BeforeParam, WithinParam, PastParam, FutureParam} {
+		if _, ok := d.param(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultTime returns the random time of the generations without time range, see timeBefore
+func (d DateTime) defaultTime() time.Time {
+	return d.timeBefore(d.currentTime())
+}
+
+// randomTime returns a random time within the range of the tag parameters, see timeRange,
+// or the default time when there is none
+func (d DateTime) randomTime() (time.Time, error) {
+	from, to, ok, err := d.timeRange()
+	if err != nil || !ok {
+		return d.defaultTime(), err
+	}
+	if span := to.Sub(from); span < math.MaxInt64 {
+		return from.Add(time.Duration(d.rnd().Int63n(int64(span) + 1))), nil
+	}
+	// ranges of more than 292 years
+	return time.Unix(from.Unix()+d.rnd().Int63n(to.Unix()-from.Unix()), 0).In(from.Location()), nil
+}
+
+// timeBefore returns a random time, in seconds, between the Unix epoch and now, or within the default time span
+// before now when the latter is not after the epoch, e.g. with a clock set in the past, see SetClock
+func (d DateTime) timeBefore(now time.Time) time.Time {
+	span := now.Unix()
+	if span <= 0 {
+		span = int64(defaultTimeSpan / time.Second)
+	}
+	return time.Unix(now.Unix()-d.rnd().Int63n(span), 0)
+}
+
+// formatted returns a random time, see randomTime, to time.Time fields and the time formatted with layout otherwise
+func (d DateTime) formatted(v reflect.Value, layout string) (interface{}, error) {
+	t, err := d.randomTime()
+	if err != nil {
+		return nil, err
+	}
+	if v.IsValid() && v.Type() == timeType {
+		return t, nil
+	}
+	return t.Format(layout), nil
+}
+
+// sequenceTime returns the next time of the monotonic sequence, see SetMonotonic, or the default time without one
+func (d DateTime) sequenceTime() time.Time {
+	if d.sequence() != nil {
+		return d.now(time.Second)
+	}
+	return d.defaultTime()
+}
+
+func (d DateTime) unixtime() (time.Time, error) {
+	if d.sequence() != nil {
+		return d.now(time.Second), nil
+	}
+	return d.randomTime()
+}
+
+// UnixTime get unix time, or the time itself to time.Time fields.
+// It is within the range of the time range tag parameters, e.g. `faker:"unix_time,past,within=30d"`.
+func (d DateTime) UnixTime(v reflect.Value) (interface{}, error) {
+	t, err := d.unixtime()
+	if err != nil {
+		return nil, err
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return t, nil
+		}
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return reflect.ValueOf(t.Unix()).Convert(v.Type()).Interface(), nil
+	}
+	return t.Unix(), nil
 }
 
 // UnixTime get unix time randomly
 func UnixTime() int64 {
 	return singleFakeData(UnixTimeTag, func() interface{} {
 		datetime := DateTime{}
-		return datetime.unixtime()
+		return datetime.sequenceTime().Unix()
 	}).(int64)
 }
 
-func (d DateTime) date() string {
-	return time.Unix(RandomUnixTime(), 0).Format(BaseDateFormat)
+func (d DateTime) date(v reflect.Value) (interface{}, error) {
+	return d.formatted(v, BaseDateFormat)
 }
 
 // Date formats DateTime using example BaseDateFormat const
 func (d DateTime) Date(v reflect.Value) (interface{}, error) {
-	return d.date(), nil
+	return d.date(v)
 }
 
 // Date get fake date in string randomly
 func Date() string {
 	return singleFakeData(DATE, func() interface{} {
 		datetime := DateTime{}
-		return datetime.date()
+		return datetime.defaultTime().Format(BaseDateFormat)
 	}).(string)
 }
 
-func (d DateTime) time() string {
-	return time.Unix(RandomUnixTime(), 0).Format(TimeFormat)
+func (d DateTime) time(v reflect.Value) (interface{}, error) {
+	return d.formatted(v, TimeFormat)
 }
 
 // Time formats DateTime using example Time const
 func (d DateTime) Time(v reflect.Value) (interface{}, error) {
-	return d.time(), nil
+	return d.time(v)
 }
 
 // TimeString get time randomly in string format
 func TimeString() string {
 	return singleFakeData(TIME, func() interface{} {
 		datetime := DateTime{}
-		return datetime.time()
+		return datetime.defaultTime().Format(TimeFormat)
 	}).(string)
 }
 
-func (d DateTime) monthName() string {
-	return time.Unix(RandomUnixTime(), 0).Format(MonthFormat)
+func (d DateTime) monthName(v reflect.Value) (interface{}, error) {
+	return d.formatted(v, MonthFormat)
 }
 
 // MonthName formats DateTime using example Month const
 func (d DateTime) MonthName(v reflect.Value) (interface{}, error) {
-	return d.monthName(), nil
+	return d.monthName(v)
 }
 
 // MonthName get month name randomly in string format
 func MonthName() string {
 	return singleFakeData(MonthNameTag, func() interface{} {
 		datetime := DateTime{}
-		return datetime.monthName()
+		return datetime.defaultTime().Format(MonthFormat)
 	}).(string)
 }
 
-func (d DateTime) year() string {
-	return time.Unix(RandomUnixTime(), 0).Format(YearFormat)
+func (d DateTime) year(v reflect.Value) (interface{}, error) {
+	return d.formatted(v, YearFormat)
 }
 
 // Year formats DateTime using example Year const
 func (d DateTime) Year(v reflect.Value) (interface{}, error) {
-	return d.year(), nil
+	return d.year(v)
 }
 
 // YearString get year randomly in string format
 func YearString() string {
 	return singleFakeData(YEAR, func() interface{} {
 		datetime := DateTime{}
-		return datetime.year()
+		return datetime.defaultTime().Format(YearFormat)
 	}).(string)
 }
 
-func (d DateTime) dayOfWeek() string {
-	return time.Unix(RandomUnixTime(), 0).Format(DayFormat)
+func (d DateTime) dayOfWeek(v reflect.Value) (interface{}, error) {
+	return d.formatted(v, DayFormat)
 }
 
 // DayOfWeek formats DateTime using example Day const
 func (d DateTime) DayOfWeek(v reflect.Value) (interface{}, error) {
-	return d.dayOfWeek(), nil
+	return d.dayOfWeek(v)
 }
 
 // DayOfWeek get day of week randomly in string format
 func DayOfWeek() string {
 	return singleFakeData(DayOfWeekTag, func() interface{} {
 		datetime := DateTime{}
-		return datetime.dayOfWeek()
+		return datetime.defaultTime().Format(DayFormat)
 	}).(string)
 }
 
-func (d DateTime) dayOfMonth() string {
-	return time.Unix(RandomUnixTime(), 0).Format(DayOfMonthFormat)
+func (d DateTime) dayOfMonth(v reflect.Value) (interface{}, error) {
+	return d.formatted(v, DayOfMonthFormat)
 }
 
 // DayOfMonth formats DateTime using example DayOfMonth const
 func (d DateTime) DayOfMonth(v reflect.Value) (interface{}, error) {
-	return d.dayOfMonth(), nil
+	return d.dayOfMonth(v)
 }
 
 // DayOfMonth get month randomly in string format
 func DayOfMonth() string {
 	return singleFakeData(DayOfMonthTag, func() interface{} {
 		datetime := DateTime{}
-		return datetime.dayOfMonth()
+		return datetime.defaultTime().Format(DayOfMonthFormat)
 	}).(string)
 }
 
-func (d DateTime) timestamp() string {
-	return time.Unix(RandomUnixTime(), 0).Format(fmt.Sprintf("%s %s", BaseDateFormat, TimeFormat))
+func (d DateTime) timestamp(v reflect.Value) (interface{}, error) {
+	if d.sequence() != nil {
+		if v.IsValid() && v.Type() == timeType {
+			return d.now(time.Second), nil
+		}
+		return d.now(time.Second).Format(fmt.Sprintf("%s %s", BaseDateFormat, TimeFormat)), nil
+	}
+	return d.formatted(v, fmt.Sprintf("%s %s", BaseDateFormat, TimeFormat))
 }
 
 // Timestamp formats DateTime using example Timestamp const
 func (d DateTime) Timestamp(v reflect.Value) (interface{}, error) {
-	return d.timestamp(), nil
+	return d.timestamp(v)
 }
 
 // Timestamp get timestamp randomly in string format: 2006-01-02 15:04:05
 func Timestamp() string {
 	return singleFakeData(TIMESTAMP, func() interface{} {
 		datetime := DateTime{}
-		return datetime.timestamp()
+		return datetime.sequenceTime().Format(fmt.Sprintf("%s %s", BaseDateFormat, TimeFormat))
 	}).(string)
 }
 
 func (d DateTime) century() string {
-	return randomElementFromSliceString(century)
+	return randomElementFromSliceString(d.rnd(), century)
 }
 
 // Century returns a random century
@@ -804,7 +977,7 @@ func Century() string {
 }
 
 func (d DateTime) timezone() string {
-	return randomElementFromSliceString(timezones)
+	return randomElementFromSliceString(d.rnd(), timezones)
 }
 
 // TimeZone returns a random timezone
@@ -820,24 +993,28 @@ func Timezone() string {
 	}).(string)
 }
 
-func (d DateTime) period() string {
-	return time.Unix(RandomUnixTime(), 0).Format(TimePeriodFormat)
+func (d DateTime) period(v reflect.Value) (interface{}, error) {
+	return d.formatted(v, TimePeriodFormat)
 }
 
 // TimePeriod formats DateTime using example TimePeriod const
 func (d DateTime) TimePeriod(v reflect.Value) (interface{}, error) {
-	return d.period(), nil
+	return d.period(v)
 }
 
 // Timeperiod get timeperiod randomly in string (AM/PM)
 func Timeperiod() string {
 	return singleFakeData(TimePeriodTag, func() interface{} {
 		datetime := DateTime{}
-		return datetime.period()
+		return datetime.defaultTime().Format(TimePeriodFormat)
 	}).(string)
 }
 
 // RandomUnixTime is a helper function returning random Unix time
 func RandomUnixTime() int64 {
-	return rand.Int63n(time.Now().Unix())
+	return randomUnixTime(globalRand)
+}
+
+func randomUnixTime(r *rand.Rand) int64 {
+	return r.Int63n(time.Now().Unix())
 }
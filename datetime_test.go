@@ -259,3 +259,115 @@ func TestFakeTimePeriod(t *testing.T) {
 		t.Error("function TimePeriod need return valid period")
 	}
 }
+
+func TestTimeRange(t *testing.T) {
+	var r struct {
+		Time      time.Time   `faker:"time,after=2020-01-01,before=2024-12-31"`
+		Pointer   *time.Time  `faker:"timestamp,after=2020-01-01,before=2024-12-31"`
+		Untagged  time.Time   `faker:"after=2020-01-01,before=2024-12-31"`
+		Slice     []time.Time `faker:"after=2020-01-01,before=2024-12-31"`
+		UnixTime  int64       `faker:"unix_time,after=2020-01-01T00:00:00Z,before=2024-12-31 23:59:59"`
+		Date      string      `faker:"date,after=2020-01-01,before=2024-12-31"`
+		Timestamp string      `faker:"timestamp,after=2020-01-01,within=30d"`
+		Year      string      `faker:"year,after=2020-01-01,before=2020-12-31"`
+	}
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC)
+	inRange := func(name string, tm time.Time, from, to time.Time) {
+		if tm.Before(from) || tm.After(to) {
+			t.Errorf("%s: expected a time between %v and %v, but got %v", name, from, to, tm)
+		}
+	}
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		inRange("Time", r.Time, from, to)
+		if r.Pointer == nil {
+			t.Fatal("expected a time to be set")
+		}
+		inRange("Pointer", *r.Pointer, from, to)
+		inRange("Untagged", r.Untagged, from, to)
+		for _, tm := range r.Slice {
+			inRange("Slice", tm, from, to)
+		}
+		inRange("UnixTime", time.Unix(r.UnixTime, 0), from, to)
+		date, err := time.Parse(BaseDateFormat, r.Date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inRange("Date", date, from, to)
+		ts, err := time.Parse(BaseDateFormat+" "+TimeFormat, r.Timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inRange("Timestamp", ts, from, from.Add(30*24*time.Hour))
+		if r.Year != "2020" {
+			t.Errorf("expected 2020, but got %s", r.Year)
+		}
+	}
+}
+
+func TestRelativeTimeRange(t *testing.T) {
+	var r struct {
+		Past       time.Time `faker:"past"`
+		Future     time.Time `faker:"future"`
+		LastMonth  int64     `faker:"unix_time,past,within=30d"`
+		NextWeek   time.Time `faker:"time,future,within=1w"`
+		Around     time.Time `faker:"within=36h"`
+		BeforeDate time.Time `faker:"before=2000-01-01,within=1y"`
+	}
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		if !r.Past.Before(now) || r.Past.Before(now.Add(-defaultTimeSpan)) {
+			t.Errorf("expected a time in the past, but got %v", r.Past)
+		}
+		if r.Future.Before(now.Add(-time.Second)) || r.Future.After(now.Add(defaultTimeSpan)) {
+			t.Errorf("expected a time in the future, but got %v", r.Future)
+		}
+		if d := now.Sub(time.Unix(r.LastMonth, 0)); d < 0 || d > 30*24*time.Hour+time.Second {
+			t.Errorf("expected a time in the last 30 days, but got %v", time.Unix(r.LastMonth, 0))
+		}
+		if d := r.NextWeek.Sub(now); d < -time.Second || d > 7*24*time.Hour {
+			t.Errorf("expected a time in the next week, but got %v", r.NextWeek)
+		}
+		if d := r.Around.Sub(now); d < -36*time.Hour-time.Second || d > 36*time.Hour {
+			t.Errorf("expected a time within 36 hours, but got %v", r.Around)
+		}
+		if y := r.BeforeDate.Year(); y != 1999 && !r.BeforeDate.Equal(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected a time in 1999, but got %v", r.BeforeDate)
+		}
+	}
+}
+
+func TestWrongTimeRange(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		err   string
+	}{
+		{&struct {
+			T time.Time `faker:"after=tomorrow"`
+		}{}, fmt.Sprintf(ErrUnknownDependency, "T", "tomorrow")},
+		{&struct {
+			Start int64
+			End   int64 `faker:"after=Strat"`
+		}{}, fmt.Sprintf(ErrUnknownDependency, "End", "Strat")},
+		{&struct {
+			T string `faker:"date,within=30x"`
+		}{}, fmt.Sprintf(ErrWrongFormattedTag, "within=30x")},
+		{&struct {
+			T int64 `faker:"unix_time,before=2020-01-01,after=2024-01-01"`
+		}{}, ErrStartValueBiggerThanEnd},
+		{&struct {
+			T time.Time `faker:"past,future"`
+		}{}, fmt.Sprintf(ErrWrongFormattedTag, "past and future cannot be used with each other or another bound")},
+	}
+	for _, test := range tests {
+		if err := FakeData(test.value); err == nil || err.Error() != test.err {
+			t.Errorf("expected the error %q, but got %v", test.err, err)
+		}
+	}
+}
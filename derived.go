@@ -0,0 +1,265 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DerivedFunction is the layout function for tags used together with "from".
+// It receives the field to fill and the values of the sibling fields listed in the tag.
+type DerivedFunction func(v reflect.Value, from []reflect.Value) (interface{}, error)
+
+// builtinDerived binds the built-in derived providers to the state of a generation
+var builtinDerived = map[string]func(p provider) DerivedFunction{
+	"":          func(p provider) DerivedFunction { return deriveJoined },
+	NAME:        func(p provider) DerivedFunction { return deriveJoined },
+	EmailTag:    func(p provider) DerivedFunction { return p.deriveEmail },
+	UserNameTag: func(p provider) DerivedFunction { return p.deriveUserName },
+	AgeTag:      func(p provider) DerivedFunction { return p.deriveAge },
+}
+
+// mapperDerived holds the providers added with AddDerivedProvider
+var mapperDerived = map[string]DerivedFunction{}
+
+// maxAfterOffset is the largest gap put between a field tagged with "after" and the field it follows
+const maxAfterOffset = 365 * 24 * time.Hour
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// AddDerivedProvider extends faker with a tag that builds its value from sibling fields
+// Example:
+//
+//	type User struct {
+//		FirstName string `faker:"first_name"`
+//		LastName  string `faker:"last_name"`
+//		Initials  string `faker:"initials,from=FirstName+LastName"`
+//	}
+//
+//	faker.AddDerivedProvider("initials", func(v reflect.Value, from []reflect.Value) (interface{}, error) {
+//		res := ""
+//		for _, f := range from {
+//			res += f.String()[:1]
+//		}
+//		return res, nil
+//	})
+func AddDerivedProvider(tag string, provider DerivedFunction) error {
+	mapperMu.Lock()
+	defer mapperMu.Unlock()
+
+	if _, ok := builtinDerived[tag]; ok {
+		return errors.New(ErrTagAlreadyExists)
+	}
+	if _, ok := mapperDerived[tag]; ok {
+		return errors.New(ErrTagAlreadyExists)
+	}
+
+	mapperDerived[tag] = provider
+
+	return nil
+}
+
+// fieldOrder returns the indexes of the struct fields in the order they have to be generated,
+// so that every field comes after the fields it depends on. Independent fields keep their declaration order.
+func fieldOrder(t reflect.Type, tags []structTag) ([]int, error) {
+	deps := make([][]int, len(tags))
+	for i, tag := range tags {
+		for _, name := range tag.dependencies() {
+			field, ok := t.FieldByName(name)
+			if !ok || len(field.Index) != 1 {
+				return nil, fmt.Errorf(ErrUnknownDependency, t.Field(i).Name, name)
+			}
+			deps[i] = append(deps[i], field.Index[0])
+		}
+	}
+
+	order := make([]int, 0, len(tags))
+	done := make([]bool, len(tags))
+	for len(order) < len(tags) {
+		progress := false
+		for i := range tags {
+			if done[i] || !allDone(deps[i], done) {
+				continue
+			}
+			done[i] = true
+			order = append(order, i)
+			progress = true
+		}
+		if !progress {
+			return nil, fmt.Errorf(ErrCyclicDependency, t.String())
+		}
+	}
+	return order, nil
+}
+
+func allDone(indexes []int, done []bool) bool {
+	for _, i := range indexes {
+		if !done[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// setDerivedValue fills the i-th field of the struct v using the sibling fields named in its tag
+func setDerivedValue(v reflect.Value, i int, tag structTag, p provider) error {
+	field := v.Field(i)
+	if tag.after != "" {
+		return setAfter(field, v.FieldByName(tag.after), p)
+	}
+
+	mapperMu.RLock()
+	fn, ok := mapperDerived[tag.fieldType]
+	mapperMu.RUnlock()
+	if bind, builtin := builtinDerived[tag.fieldType]; builtin {
+		fn, ok = bind(p), true
+	}
+	if !ok {
+		return fmt.Errorf(ErrTagNotSupported, tag.fieldType)
+	}
+	from := make([]reflect.Value, 0, len(tag.from))
+	for _, name := range tag.from {
+		from = append(from, v.FieldByName(name))
+	}
+	res, err := fn(field, from)
+	if err != nil {
+		return err
+	}
+	rval := reflect.ValueOf(res)
+	if !rval.IsValid() || !rval.Type().ConvertibleTo(field.Type()) {
+		return errors.New(ErrNotSupportedTypeForTag)
+	}
+	field.Set(rval.Convert(field.Type()))
+	return nil
+}
+
+// setAfter sets field to a random moment strictly after the one held by previous.
+// Both values can be a time.Time, a *time.Time or an integer holding a unix time.
+func setAfter(field, previous reflect.Value, p provider) error {
+	start, ok := timeOf(previous, p.currentTime())
+	if !ok {
+		return errors.New(ErrNotSupportedTypeForTag)
+	}
+	offset := time.Second + time.Duration(p.rnd().Int63n(int64(maxAfterOffset)))
+	return setTime(field, start.Add(offset))
+}
+
+// timeOf returns the time held by v, now for nil time pointers
+func timeOf(v reflect.Value, now time.Time) (time.Time, bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return now, v.Type().Elem() == timeType
+		}
+		return timeOf(v.Elem(), now)
+	case reflect.Struct:
+		t, ok := v.Interface().(time.Time)
+		return t, ok
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return time.Unix(toInt64(v), 0), true
+	}
+	return time.Time{}, false
+}
+
+func setTime(v reflect.Value, t time.Time) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.Type().Elem() != timeType {
+			return errors.New(ErrNotSupportedTypeForTag)
+		}
+		v.Set(reflect.ValueOf(&t))
+	case reflect.Struct:
+		if v.Type() != timeType {
+			return errors.New(ErrNotSupportedTypeForTag)
+		}
+		v.Set(reflect.ValueOf(t))
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		v.SetInt(t.Unix())
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(t.Unix()))
+	default:
+		return errors.New(ErrNotSupportedTypeForTag)
+	}
+	return nil
+}
+
+func toInt64(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint())
+	}
+	return v.Int()
+}
+
+// derivedParts returns the text of the given values, skipping the empty ones
+func derivedParts(from []reflect.Value) []string {
+	parts := make([]string, 0, len(from))
+	for _, f := range from {
+		for f.Kind() == reflect.Ptr && !f.IsNil() {
+			f = f.Elem()
+		}
+		if f.Kind() == reflect.Ptr {
+			continue
+		}
+		if part := fmt.Sprint(f.Interface()); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// alphanumeric lower cases s and drops everything that is not a letter or a digit
+func alphanumeric(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+func deriveJoined(v reflect.Value, from []reflect.Value) (interface{}, error) {
+	return strings.Join(derivedParts(from), " "), nil
+}
+
+// deriveAge returns the age, at the current time of the generation, of a person born at the time held by the single
+// field of from: a time.Time, a *time.Time, an integer holding a unix time or a string formatted as 2006-01-02
+func (p provider) deriveAge(v reflect.Value, from []reflect.Value) (interface{}, error) {
+	if len(from) != 1 {
+		return nil, errors.New(ErrNotSupportedTypeForTag)
+	}
+	now := p.currentTime()
+	birth, ok := timeOf(from[0], now)
+	if from[0].Kind() == reflect.String {
+		birth, ok = parseDate(from[0].String())
+	}
+	if !ok {
+		return nil, errors.New(ErrNotSupportedTypeForTag)
+	}
+	return yearsBetween(birth, now), nil
+}
+
+func (p provider) deriveEmail(v reflect.Value, from []reflect.Value) (interface{}, error) {
+	parts := make([]string, 0, len(from))
+	for _, part := range derivedParts(from) {
+		if part = alphanumeric(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	i := Internet{p}
+	if len(parts) == 0 {
+		return i.email(), nil
+	}
+	return strings.Join(parts, ".") + "@" + i.domainName(), nil
+}
+
+func (p provider) deriveUserName(v reflect.Value, from []reflect.Value) (interface{}, error) {
+	res := alphanumeric(strings.Join(derivedParts(from), ""))
+	if res == "" {
+		return Internet{p}.username(), nil
+	}
+	return res, nil
+}
@@ -0,0 +1,223 @@
+package faker
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFromTag(t *testing.T) {
+	type User struct {
+		Email     string `faker:"email,from=FirstName+LastName"`
+		UserName  string `faker:"username,from=FirstName+LastName"`
+		FullName  string `faker:"from=FirstName+LastName"`
+		FirstName string `faker:"first_name"`
+		LastName  string `faker:"last_name"`
+	}
+
+	for i := 0; i < 20; i++ {
+		u := User{}
+		if err := FakeData(&u); err != nil {
+			t.Fatal("Expected NoError, but Got Err: ", err)
+		}
+		if u.FullName != u.FirstName+" "+u.LastName {
+			t.Errorf("expected full name %q to be built from %q and %q", u.FullName, u.FirstName, u.LastName)
+		}
+		local := alphanumeric(u.FirstName) + "." + alphanumeric(u.LastName) + "@"
+		if !strings.HasPrefix(u.Email, local) {
+			t.Errorf("expected email %q to start with %q", u.Email, local)
+		}
+		if u.UserName != alphanumeric(u.FirstName+u.LastName) {
+			t.Errorf("expected username %q to be built from %q and %q", u.UserName, u.FirstName, u.LastName)
+		}
+	}
+}
+
+func TestAfterTag(t *testing.T) {
+	type Record struct {
+		UpdatedAt  time.Time  `faker:"after=CreatedAt"`
+		DeletedAt  *time.Time `faker:"after=UpdatedAt"`
+		ArchivedAt int64      `faker:"after=DeletedAt"`
+		CreatedAt  time.Time
+	}
+
+	for i := 0; i < 20; i++ {
+		r := Record{}
+		if err := FakeData(&r); err != nil {
+			t.Fatal("Expected NoError, but Got Err: ", err)
+		}
+		if !r.UpdatedAt.After(r.CreatedAt) {
+			t.Errorf("expected %v to be after %v", r.UpdatedAt, r.CreatedAt)
+		}
+		if r.DeletedAt == nil || !r.DeletedAt.After(r.UpdatedAt) {
+			t.Errorf("expected %v to be after %v", r.DeletedAt, r.UpdatedAt)
+		}
+		if r.ArchivedAt <= r.DeletedAt.Unix() {
+			t.Errorf("expected %d to be after %d", r.ArchivedAt, r.DeletedAt.Unix())
+		}
+	}
+}
+
+func TestFromTagKeepsOriginal(t *testing.T) {
+	type User struct {
+		FirstName string `faker:"first_name"`
+		Email     string `faker:"email,keep,from=FirstName"`
+	}
+
+	u := User{Email: "kept@example.com"}
+	if err := FakeData(&u); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if u.Email != "kept@example.com" {
+		t.Errorf("expected the email to be kept, but got %s", u.Email)
+	}
+}
+
+func TestAfterTagKeepsOriginal(t *testing.T) {
+	type Booking struct {
+		CheckIn  time.Time `faker:"after=2020-01-01,keep"`
+		CheckOut time.Time `faker:"after=CheckIn,keep"`
+	}
+
+	kept := time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)
+	b := Booking{CheckIn: kept}
+	if err := FakeData(&b); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if !b.CheckIn.Equal(kept) || !b.CheckOut.After(kept) {
+		t.Errorf("expected the check in to be kept and the check out after it, but got %+v", b)
+	}
+
+	b = Booking{CheckOut: kept}
+	if err := FakeData(&b); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if b.CheckIn.Before(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)) || !b.CheckOut.Equal(kept) {
+		t.Errorf("expected a check in after 2020-01-01 and the check out to be kept, but got %+v", b)
+	}
+}
+
+func TestDependencyErrors(t *testing.T) {
+	unknown := struct {
+		Email string `faker:"email,from=Missing"`
+	}{}
+	if err := FakeData(&unknown); err == nil {
+		t.Error("Expected error on unknown dependency, but got nil")
+	}
+
+	cyclic := struct {
+		A time.Time `faker:"after=B"`
+		B time.Time `faker:"after=A"`
+	}{}
+	if err := FakeData(&cyclic); err == nil {
+		t.Error("Expected error on cyclic dependency, but got nil")
+	}
+
+	unsupported := struct {
+		Name string
+		Word string `faker:"word,from=Name"`
+	}{}
+	if err := FakeData(&unsupported); err == nil {
+		t.Error("Expected error on tag without derived provider, but got nil")
+	}
+}
+
+func TestUniqueDerived(t *testing.T) {
+	defer saveDatasets()()
+	defer ResetUnique()
+
+	if err := SetDataset("first_names", []string{"Ada", "Alan", "Grace"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetDataset("last_names", []string{"Lovelace", "Turing", "Hopper"}); err != nil {
+		t.Fatal(err)
+	}
+	type Member struct {
+		FirstName string `faker:"first_name"`
+		LastName  string `faker:"last_name"`
+		UserName  string `faker:"username,from=FirstName+LastName,unique"`
+		Email     string `faker:"email,from=FirstName+LastName,unique"`
+	}
+	// the names are generated again until their user name is unique
+	seen := map[string]bool{}
+	for i := 0; i < 9; i++ {
+		var m Member
+		if err := FakeData(&m); err != nil {
+			t.Fatal(err)
+		}
+		if seen[m.UserName] || m.UserName != strings.ToLower(m.FirstName+m.LastName) {
+			t.Errorf("expected a unique user name of the names, but got %+v", m)
+		}
+		if !strings.HasPrefix(m.Email, strings.ToLower(m.FirstName+"."+m.LastName)+"@") {
+			t.Errorf("expected the email of the names, but got %+v", m)
+		}
+		seen[m.UserName] = true
+	}
+	if err := FakeData(&Member{}); err == nil || err.Error() != fmt.Sprintf(ErrUniqueFailure, "UserName") {
+		t.Errorf("expected the user names to be exhausted, but got %v", err)
+	}
+
+	ResetUnique()
+	var members []Member
+	if err := FakeMany(&members, 9, Workers(4)); err != nil {
+		t.Fatal(err)
+	}
+	seen = map[string]bool{}
+	for _, m := range members {
+		if seen[m.UserName] {
+			t.Errorf("expected unique user names, but got %s twice", m.UserName)
+		}
+		seen[m.UserName] = true
+	}
+}
+
+func TestDerivedProviderReturningNil(t *testing.T) {
+	if err := AddDerivedProvider("nothing", func(v reflect.Value, from []reflect.Value) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	defer func() {
+		mapperMu.Lock()
+		delete(mapperDerived, "nothing")
+		mapperMu.Unlock()
+	}()
+
+	s := struct {
+		Name    string
+		Nothing string `faker:"nothing,from=Name"`
+	}{}
+	if err := FakeData(&s); err == nil || err.Error() != ErrNotSupportedTypeForTag {
+		t.Error("Expected ErrNotSupportedTypeForTag, but got ", err)
+	}
+}
+
+func TestAddDerivedProvider(t *testing.T) {
+	err := AddDerivedProvider("initials", func(v reflect.Value, from []reflect.Value) (interface{}, error) {
+		res := ""
+		for _, f := range from {
+			res += f.String()[:1]
+		}
+		return res, nil
+	})
+	if err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if err := AddDerivedProvider("initials", nil); err == nil || err.Error() != ErrTagAlreadyExists {
+		t.Error("Expected ErrTagAlreadyExists Error,  But Got: ", err)
+	}
+
+	u := struct {
+		FirstName string `faker:"first_name"`
+		LastName  string `faker:"last_name"`
+		Initials  string `faker:"initials,from=FirstName+LastName"`
+	}{}
+	if err := FakeData(&u); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if u.Initials != u.FirstName[:1]+u.LastName[:1] {
+		t.Errorf("expected initials of %s %s, but got %s", u.FirstName, u.LastName, u.Initials)
+	}
+}
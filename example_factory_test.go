@@ -0,0 +1,35 @@
+package faker_test
+
+import (
+	"fmt"
+
+	"github.com/togglhire/faker/v3"
+)
+
+// Member ...
+type Member struct {
+	Name  string `faker:"name"`
+	Email string
+	Role  string
+}
+
+// You can define factories with traits and sequences to build your test data.
+func Example_factory() {
+	members := faker.Define("member", Member{}, faker.Override(map[string]interface{}{"Role": "member"})).
+		Trait("admin", faker.Override(map[string]interface{}{"Role": "admin"})).
+		Sequence("Email", func(n int) interface{} { return fmt.Sprintf("member-%d@example.com", n) })
+
+	var admin Member
+	_ = members.Build(&admin, "admin")
+	fmt.Println(admin.Email, admin.Role)
+
+	var list []Member
+	_ = members.BuildList(&list, 2)
+	for _, m := range list {
+		fmt.Println(m.Email, m.Role)
+	}
+	// Output:
+	// member-1@example.com admin
+	// member-2@example.com member
+	// member-3@example.com member
+}
@@ -0,0 +1,28 @@
+package faker_test
+
+import (
+	"fmt"
+
+	"github.com/togglhire/faker/v3"
+)
+
+// Customer is a struct generated in a given locale
+type Customer struct {
+	Name     string `faker:"name"`
+	Phone    string `faker:"phone_number"`
+	Currency string `faker:"currency"`
+}
+
+// You can generate the values in one of the bundled locales, the providers fall back to en_US for what it lacks.
+func Example_withLocale() {
+	c := Customer{}
+	err := faker.FakeData(&c, faker.WithLocale("de_DE"))
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(c.Currency)
+	fmt.Println(faker.Locales())
+	// Output:
+	// EUR
+	// [de_DE en_US es_ES fr_FR ja_JP pt_BR]
+}
@@ -0,0 +1,29 @@
+package faker_test
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/togglhire/faker/v3"
+)
+
+// Visitor ...
+type Visitor struct {
+	Name  string `faker:"name"`
+	Email string `faker:"email,unique"`
+	IP    string `faker:"ipv4"`
+}
+
+// You can generate many records at once on several goroutines, the same seed always generating the same records.
+func Example_fakeMany() {
+	var visitors []Visitor
+	_ = faker.FakeMany(&visitors, 1000, faker.Workers(8), faker.Seed(42))
+	faker.ResetUnique()
+
+	var again []Visitor
+	_ = faker.FakeMany(&again, 1000, faker.Workers(2), faker.Seed(42))
+	faker.ResetUnique()
+
+	fmt.Println(len(visitors), reflect.DeepEqual(visitors, again))
+	// Output: 1000 true
+}
@@ -0,0 +1,31 @@
+package faker_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/togglhire/faker/v3"
+)
+
+// LogEntry is a record of an event log
+type LogEntry struct {
+	Timestamp string `faker:"timestamp"`
+	ID        string `faker:"uuid_v7"`
+}
+
+// You can make the timestamps and the time ordered IDs of a batch of records increase from one record to the next.
+func Example_monotonic() {
+	seq := faker.NewSequence(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 0)
+	for i := 0; i < 3; i++ {
+		e := LogEntry{}
+		err := faker.FakeData(&e, faker.Monotonic(seq))
+		if err != nil {
+			fmt.Println(err)
+		}
+		fmt.Println(e.Timestamp)
+	}
+	// Output:
+	// 2024-01-01 00:00:00
+	// 2024-01-01 00:00:01
+	// 2024-01-01 00:00:02
+}
@@ -6,8 +6,13 @@ import "github.com/togglhire/faker/v3"
 func Example_singleFakeData() {
 
 	// Address
-	faker.Latitude()  // => 81.12195
-	faker.Longitude() // => -84.38158
+	faker.Latitude()         // => 81.12195
+	faker.Longitude()        // => -84.38158
+	faker.StreetAddress()    // => 742 Oak Avenue
+	faker.City()             // => Denver
+	faker.Postcode()         // => 80214
+	faker.CountryCode()      // => NL
+	faker.FormattedAddress() // => 742 Oak Avenue Apt. 12\nDenver, CO 80214\nUnited States
 
 	// Datetime
 	faker.UnixTime()   // => 1197930901
@@ -41,7 +46,8 @@ func Example_singleFakeData() {
 	faker.CCType()             // => American Express
 	faker.CCNumber()           // => 373641309057568
 	faker.Currency()           // => USD
-	faker.AmountWithCurrency() // => USD 49257.100
+	faker.AmountWithCurrency() // => USD 49257.10
+	faker.FormattedAmount()    // => $49,257.10
 
 	// Person
 	faker.TitleMale()       // => Mr.
@@ -54,12 +60,18 @@ func Example_singleFakeData() {
 
 	// Phone
 	faker.Phonenumber()         // -> 201-886-0269
-	faker.TollFreePhoneNumber() // => (777) 831-964572
-	faker.E164PhoneNumber()     // => +724891571063
+	faker.TollFreePhoneNumber() // => (888) 831-9645
+	faker.E164PhoneNumber()     // => +17924891571
 
 	//  UUID
 	faker.UUIDHyphenated() // => 8f8e4463-9560-4a38-9b0c-ef24481e4e27
 	faker.UUIDDigit()      // => 90ea6479fd0e4940af741f0a87596b73
+	faker.UUIDv7()         // => 01890a5d-ac96-774b-bcce-b302099a8057
+	faker.ULID()           // => 01H4556B4PEY5FQZGKWGZ4BXQ0
+	faker.KSUID()          // => 2SFr4bNqNfKQYV2QZzWgyRLjEJU
+	faker.ObjectID()       // => 64a1f3c2e4b0c8a1d2f3e4b5
+	faker.Snowflake()      // => 1675893749012463616
+	faker.NanoID()         // => V1StGXR8_Z5jdHi6B-myT
 
 	// Unique values
 	faker.SetGenerateUniqueValues(true) // Enable unique data generation on single fake data functions
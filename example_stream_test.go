@@ -0,0 +1,35 @@
+package faker_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/togglhire/faker/v3"
+)
+
+// You can stream fake values, they are generated as they are received until the context is done.
+func Example_streamData() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	visitors, errc := faker.StreamData(ctx, Visitor{})
+	count := 0
+	for v := range visitors {
+		if v.(Visitor).Email != "" {
+			count++
+		}
+		if count == 100 {
+			cancel()
+			break
+		}
+	}
+	fmt.Println(count)
+	for range visitors {
+		// discard the value generated while cancelling
+	}
+	fmt.Println(<-errc)
+	faker.ResetUnique()
+	// Output:
+	// 100
+	// context canceled
+}
@@ -0,0 +1,47 @@
+package faker_test
+
+import (
+	"fmt"
+
+	"github.com/togglhire/faker/v3"
+)
+
+// Address ...
+type Address struct {
+	Street  string
+	Country string
+}
+
+// SomeStructWithOverrides ...
+type SomeStructWithOverrides struct {
+	Name    string `faker:"name"`
+	Role    string
+	Address Address
+}
+
+// You can set the fields you care about and let faker fill everything else.
+func Example_withOptions() {
+	a := SomeStructWithOverrides{}
+	err := faker.FakeData(&a,
+		faker.Override(map[string]interface{}{"Address.Country": "DE"}),
+		faker.With(func(a *SomeStructWithOverrides) { a.Role = "admin" }),
+	)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(a.Role, a.Address.Country)
+	// Output:
+	// admin DE
+}
+
+// You can also fill only the zero valued fields of a partially populated struct.
+func Example_withMerge() {
+	a := SomeStructWithOverrides{Role: "admin", Address: Address{Country: "DE"}}
+	err := faker.FakeData(&a, faker.Merge())
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(a.Role, a.Address.Country, a.Address.Street != "")
+	// Output:
+	// admin DE true
+}
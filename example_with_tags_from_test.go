@@ -0,0 +1,39 @@
+package faker_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/togglhire/faker/v3"
+)
+
+// SomeStructWithDependencies ...
+type SomeStructWithDependencies struct {
+	FirstName string    `faker:"first_name"`
+	LastName  string    `faker:"last_name"`
+	FullName  string    `faker:"from=FirstName+LastName"`
+	Email     string    `faker:"email,from=FirstName+LastName"`
+	UpdatedAt time.Time `faker:"after=CreatedAt"`
+	CreatedAt time.Time
+}
+
+// You can build a field from its sibling fields, the fields it depends on are always generated first.
+func Example_withTagsFrom() {
+	a := SomeStructWithDependencies{}
+	err := faker.FakeData(&a)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Printf("%+v", a)
+	// Result:
+	/*
+		{
+			FirstName:Lura
+			LastName:Stokes
+			FullName:Lura Stokes
+			Email:lura.stokes@wuzyqgh.com
+			UpdatedAt:2264-07-16 10:40:07.517926823 +0200 CEST
+			CreatedAt:2263-11-02 04:11:56.275124411 +0100 CET
+		}
+	*/
+}
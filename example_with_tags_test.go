@@ -10,6 +10,11 @@ import (
 type SomeStructWithTags struct {
 	Latitude           float32 `faker:"lat"`
 	Longitude          float32 `faker:"long"`
+	StreetAddress      string  `faker:"street_address"`
+	City               string  `faker:"city"`
+	State              string  `faker:"state"`
+	Postcode           string  `faker:"postcode"`
+	CountryCode        string  `faker:"country_code"`
 	CreditCardNumber   string  `faker:"cc_number"`
 	CreditCardType     string  `faker:"cc_type"`
 	Email              string  `faker:"email"`
@@ -65,6 +70,11 @@ func Example_withTags() {
 		{
 			Latitude: 81.12195
 			Longitude: -84.38158
+			StreetAddress: 742 Oak Avenue
+			City: Denver
+			State: Texas
+			Postcode: 98134
+			CountryCode: NL
 			CreditCardType: American Express
 			CreditCardNumber: 373641309057568
 			Email: mJBJtbv@OSAaT.ru
@@ -76,8 +86,8 @@ func Example_withTags() {
 			MacAddress: cd:65:e1:d4:76:c6
 			URL: https://www.oEuqqAY.org/QgqfOhd
 			UserName: lVxELHS
-			TollFreeNumber: (777) 831-964572
-			E164PhoneNumber: +724891571063
+			TollFreeNumber: (888) 831-9645
+			E164PhoneNumber: +17924891571
 			TitleMale: Mr.
 			TitleFemale: Queen
 			FirstName: Whitney
@@ -101,7 +111,7 @@ func Example_withTags() {
 			Paragraph: Aut consequatur sit perferendis accusantium voluptatem. Accusantium perferendis consequatur voluptatem sit aut. Aut sit accusantium consequatur voluptatem perferendis. Perferendis voluptatem aut accusantium consequatur sit.
 			Currency: IRR,
 			Amount: 88.990000,
-			AmountWithCurrency: XBB 49257.100000,
+			AmountWithCurrency: XBB 49257,
 			UUIDHypenated: 8f8e4463-9560-4a38-9b0c-ef24481e4e27,
 			UUID: 90ea6479fd0e4940af741f0a87596b73,
 			Skip:
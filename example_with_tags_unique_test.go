@@ -25,3 +25,25 @@ func Example_withTagsAndUnique() {
 	// Result:
 	//	{Word:nobis}{Word:recusandae}{Word:praesentium}{Word:doloremque}{Word:non}
 }
+
+// SomeStructWithUniqueTogether ...
+type SomeStructWithUniqueTogether struct {
+	_        struct{} `faker:"unique_together=TenantID,Slug"`
+	TenantID int      `faker:"boundary_start=1, boundary_end=3"`
+	Slug     string   `faker:"word"`
+}
+
+func Example_withTagsAndUniqueTogether() {
+	for i := 0; i < 5; i++ { // Generate 5 structs having a unique combination of tenant and slug
+		a := SomeStructWithUniqueTogether{}
+		err := faker.FakeData(&a)
+		if err != nil {
+			fmt.Println(err)
+		}
+		fmt.Printf("%+v", a)
+	}
+	faker.ResetUnique()
+
+	// Result:
+	//	{_:{} TenantID:1 Slug:nobis}{_:{} TenantID:2 Slug:nobis}{_:{} TenantID:1 Slug:non}{_:{} TenantID:2 Slug:aut}{_:{} TenantID:1 Slug:aut}
+}
@@ -0,0 +1,156 @@
+package faker
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	factoriesMu = &sync.Mutex{}
+	factories   = map[string]*Factory{}
+)
+
+// Factory builds fake values of a single type from default options, named traits and sequences.
+//
+//	users := faker.Define("user", User{}, faker.Override(map[string]interface{}{"Role": "member"})).
+//		Trait("admin", faker.Override(map[string]interface{}{"Role": "admin"})).
+//		Sequence("Email", func(n int) interface{} { return fmt.Sprintf("user-%d@example.com", n) })
+//
+//	var admin User
+//	err := users.Build(&admin, "admin")
+type Factory struct {
+	name      string
+	typ       reflect.Type
+	defaults  []Option
+	traits    map[string][]Option
+	sequences []sequence
+
+	mu    sync.Mutex
+	count int
+}
+
+type sequence struct {
+	path string
+	fn   func(n int) interface{}
+}
+
+// Define creates a factory for the type of sample and registers it under name, replacing any factory
+// previously registered with the same name. The defaults are applied to every value it builds.
+func Define(name string, sample interface{}, defaults ...Option) *Factory {
+	t := reflect.TypeOf(sample)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	f := &Factory{
+		name:     name,
+		typ:      t,
+		defaults: defaults,
+		traits:   map[string][]Option{},
+	}
+
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[name] = f
+	return f
+}
+
+// GetFactory returns the factory registered under name by Define, or nil if there is none
+func GetFactory(name string) *Factory {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	return factories[name]
+}
+
+// Name returns the name the factory was defined with
+func (f *Factory) Name() string {
+	return f.name
+}
+
+// Trait adds a named set of options that can be requested when building a value
+func (f *Factory) Trait(name string, opts ...Option) *Factory {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.traits[name] = opts
+	return f
+}
+
+// Sequence sets the field found at path from fn, called with the number of the value being built:
+// 1 for the first value built by the factory, 2 for the second one, and so on.
+func (f *Factory) Sequence(path string, fn func(n int) interface{}) *Factory {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sequences = append(f.sequences, sequence{path: path, fn: fn})
+	return f
+}
+
+// ResetSequences restarts the sequences of the factory from 1
+func (f *Factory) ResetSequences() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.count = 0
+}
+
+// Build fakes the value pointed by out with the defaults of the factory, its sequences
+// and the given traits, in that order, so traits take precedence.
+func (f *Factory) Build(out interface{}, traits ...string) error {
+	if f.typ == nil || reflect.TypeOf(out) != reflect.PtrTo(f.typ) {
+		return fmt.Errorf(ErrWrongFactoryType, f.name, f.typ, out)
+	}
+	opts, err := f.options(traits)
+	if err != nil {
+		return err
+	}
+	return FakeData(out, opts...)
+}
+
+// BuildList fakes n values with Build and stores them in the slice pointed by out
+func (f *Factory) BuildList(out interface{}, n int, traits ...string) error {
+	if n < 0 {
+		return fmt.Errorf(ErrSmallerThanZero, n)
+	}
+	t := reflect.TypeOf(out)
+	if f.typ == nil || t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Slice || t.Elem().Elem() != f.typ {
+		return fmt.Errorf(ErrWrongFactoryType, f.name, "[]"+fmt.Sprint(f.typ), out)
+	}
+
+	list := reflect.MakeSlice(t.Elem(), n, n)
+	for i := 0; i < n; i++ {
+		if err := f.Build(list.Index(i).Addr().Interface(), traits...); err != nil {
+			return err
+		}
+	}
+	reflect.ValueOf(out).Elem().Set(list)
+	return nil
+}
+
+// options returns the options to build the next value with
+func (f *Factory) options(traits []string) ([]Option, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var traitOpts []Option
+	for _, name := range traits {
+		trait, ok := f.traits[name]
+		if !ok {
+			return nil, fmt.Errorf(ErrTraitNotFound, name, f.name)
+		}
+		traitOpts = append(traitOpts, trait...)
+	}
+
+	opts := append([]Option{}, f.defaults...)
+	if len(f.sequences) > 0 {
+		// the traits are checked first, so that a failed call doesn't take a sequence number
+		f.count++
+		values := make(map[string]interface{}, len(f.sequences))
+		for _, seq := range f.sequences {
+			values[seq.path] = seq.fn(f.count)
+		}
+		opts = append(opts, Override(values))
+	}
+	return append(opts, traitOpts...), nil
+}
@@ -0,0 +1,51 @@
+//go:build go1.18
+// +build go1.18
+
+package faker
+
+// TypedFactory is a Factory building values of type T, returned by DefineFor
+type TypedFactory[T any] struct {
+	*Factory
+}
+
+// DefineFor is Define for values of type T, whose Build returns them instead of filling a pointer, e.g.
+//
+//	users := faker.DefineFor[User]("user").Trait("admin", faker.Override(map[string]interface{}{"Role": "admin"}))
+//	admin, err := users.Build("admin")
+//
+// The factory is registered under name like with Define.
+func DefineFor[T any](name string, defaults ...Option) *TypedFactory[T] {
+	var sample T
+	return &TypedFactory[T]{Define(name, &sample, defaults...)}
+}
+
+// Trait adds a named set of options that can be requested when building a value, see Factory.Trait
+func (f *TypedFactory[T]) Trait(name string, opts ...Option) *TypedFactory[T] {
+	f.Factory.Trait(name, opts...)
+	return f
+}
+
+// Sequence sets the field found at path from fn, see Factory.Sequence
+func (f *TypedFactory[T]) Sequence(path string, fn func(n int) interface{}) *TypedFactory[T] {
+	f.Factory.Sequence(path, fn)
+	return f
+}
+
+// Build fakes a value with the defaults of the factory, its sequences and the given traits, see Factory.Build
+func (f *TypedFactory[T]) Build(traits ...string) (T, error) {
+	var res T
+	if err := f.Factory.Build(&res, traits...); err != nil {
+		var zero T
+		return zero, err
+	}
+	return res, nil
+}
+
+// BuildList fakes n values with Build
+func (f *TypedFactory[T]) BuildList(n int, traits ...string) ([]T, error) {
+	var res []T
+	if err := f.Factory.BuildList(&res, n, traits...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,38 @@
+//go:build go1.18
+// +build go1.18
+
+package faker
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDefineFor(t *testing.T) {
+	users := DefineFor[FactoryUser]("typed_user", Override(map[string]interface{}{"Role": "member"})).
+		Trait("admin", Override(map[string]interface{}{"Role": "admin"})).
+		Sequence("Email", func(n int) interface{} { return fmt.Sprintf("user-%d@example.com", n) })
+	if GetFactory("typed_user") != users.Factory {
+		t.Fatal("expected the factory to be registered")
+	}
+
+	admin, err := users.Build("admin")
+	if err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if admin.Role != "admin" || admin.Email != "user-1@example.com" || admin.Name == "" {
+		t.Errorf("unexpected admin %+v", admin)
+	}
+
+	list, err := users.BuildList(2)
+	if err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if len(list) != 2 || list[0].Role != "member" || list[1].Email != "user-3@example.com" {
+		t.Errorf("unexpected users %+v", list)
+	}
+
+	if _, err := users.Build("missing"); err == nil {
+		t.Error("expected an error for an unknown trait, but got nil")
+	}
+}
@@ -0,0 +1,94 @@
+package faker
+
+import (
+	"fmt"
+	"testing"
+)
+
+type FactoryUser struct {
+	Name      string `faker:"name"`
+	Email     string `faker:"email"`
+	Role      string
+	Suspended bool
+}
+
+func defineUserFactory() *Factory {
+	return Define("user", FactoryUser{}, Override(map[string]interface{}{"Role": "member", "Suspended": false})).
+		Trait("admin", Override(map[string]interface{}{"Role": "admin"})).
+		Trait("suspended", With(func(u *FactoryUser) { u.Suspended = true })).
+		Sequence("Email", func(n int) interface{} { return fmt.Sprintf("user-%d@example.com", n) })
+}
+
+func TestFactoryBuild(t *testing.T) {
+	users := defineUserFactory()
+	if GetFactory("user") != users || users.Name() != "user" {
+		t.Fatal("expected the factory to be registered")
+	}
+
+	var member, admin FactoryUser
+	if err := users.Build(&member); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if err := users.Build(&admin, "admin", "suspended"); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+
+	if member.Role != "member" || member.Suspended || member.Email != "user-1@example.com" || member.Name == "" {
+		t.Errorf("unexpected member %+v", member)
+	}
+	if admin.Role != "admin" || !admin.Suspended || admin.Email != "user-2@example.com" {
+		t.Errorf("unexpected admin %+v", admin)
+	}
+}
+
+func TestFactoryBuildList(t *testing.T) {
+	users := defineUserFactory()
+
+	var list []FactoryUser
+	if err := users.BuildList(&list, 3, "admin"); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if len(list) != 3 {
+		t.Fatalf("expected 3 users, but got %d", len(list))
+	}
+	for i, u := range list {
+		if u.Role != "admin" || u.Email != fmt.Sprintf("user-%d@example.com", i+1) {
+			t.Errorf("unexpected user %+v", u)
+		}
+	}
+
+	users.ResetSequences()
+	var u FactoryUser
+	if err := users.Build(&u); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if u.Email != "user-1@example.com" {
+		t.Errorf("expected the sequence to restart, but got %s", u.Email)
+	}
+}
+
+func TestFactoryErrors(t *testing.T) {
+	users := defineUserFactory()
+
+	var u FactoryUser
+	if err := users.Build(&u, "admin", "unknown"); err == nil || err.Error() != fmt.Sprintf(ErrTraitNotFound, "unknown", "user") {
+		t.Error("expected error on unknown trait, but got ", err)
+	}
+	if err := users.Build(&u); err != nil || u.Email != "user-1@example.com" {
+		t.Errorf("expected the failed build not to take a sequence number, but got %s, %v", u.Email, err)
+	}
+	if err := users.Build(u); err == nil {
+		t.Error("expected error on value not being a pointer, but got nil")
+	}
+	var other SampleStruct
+	if err := users.Build(&other); err == nil {
+		t.Error("expected error on wrong type, but got nil")
+	}
+	var list []FactoryUser
+	if err := users.BuildList(&list, -1); err == nil {
+		t.Error("expected error on negative size, but got nil")
+	}
+	if err := users.BuildList(list, 1); err == nil {
+		t.Error("expected error on value not being a pointer to a slice, but got nil")
+	}
+}
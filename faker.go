@@ -3,6 +3,7 @@ package faker
 // Faker is a simple fake data generator for your own struct.
 // Save your time, and Fake your data for your testing now.
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -11,12 +12,16 @@ import (
 	"strings"
 	"sync"
 	"time"
-
-	"github.com/togglhire/faker/v3/support/slice"
 )
 
 var (
 	mu = &sync.Mutex{}
+	// Guards the settings below, changed by the Set* functions
+	settingsMu = &sync.RWMutex{}
+	// Guards uniqueValues
+	uniqueMu = &sync.Mutex{}
+	// Guards mapperTag and mapperDerived
+	mapperMu = &sync.RWMutex{}
 	// Sets nil if the value type is struct or map and the size of it equals to zero.
 	shouldSetNil = false
 	//Sets random integer generation to zero for slice and maps
@@ -30,9 +35,11 @@ var (
 	// Sets the single fake data generator to generate unique values
 	generateUniqueValues = false
 	// Unique values are kept in memory so the generator retries if the value already exists
-	uniqueValues = map[string][]interface{}{}
+	uniqueValues = map[string]uniqueSet{}
 	// Uses randomSize as constant
 	isFixedSize = false
+	// Sets the max number of retry for unique values
+	maxRetry = 10000
 )
 
 type numberBoundary struct {
@@ -45,13 +52,23 @@ const (
 	letterIdxBits         = 6                    // 6 bits to represent a letter index
 	letterIdxMask         = 1<<letterIdxBits - 1 // All 1-bits, as many as letterIdxBits
 	letterIdxMax          = 63 / letterIdxBits   // # of letter indices fitting in 63 bits
-	maxRetry              = 10000                // max number of retry for unique values
 	letterBytes           = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	tagName               = "faker"
 	keep                  = "keep"
 	unique                = "unique"
+	UniqueTogether        = "unique_together"
 	ID                    = "uuid_digit"
 	HyphenatedID          = "uuid_hyphenated"
+	UUIDV1Tag             = "uuid_v1"
+	UUIDV3Tag             = "uuid_v3"
+	UUIDV5Tag             = "uuid_v5"
+	UUIDV6Tag             = "uuid_v6"
+	UUIDV7Tag             = "uuid_v7"
+	ULIDTag               = "ulid"
+	KSUIDTag              = "ksuid"
+	ObjectIDTag           = "object_id"
+	SnowflakeTag          = "snowflake"
+	NanoIDTag             = "nanoid"
 	EmailTag              = "email"
 	MacAddressTag         = "mac_address"
 	DomainNameTag         = "domain_name"
@@ -62,8 +79,34 @@ const (
 	PASSWORD              = "password"
 	LATITUDE              = "lat"
 	LONGITUDE             = "long"
+	StreetAddressTag      = "street_address"
+	StreetNameTag         = "street_name"
+	BuildingNumberTag     = "building_number"
+	SecondaryAddressTag   = "secondary_address"
+	CityTag               = "city"
+	StateTag              = "state"
+	StateAbbrTag          = "state_abbr"
+	PostcodeTag           = "postcode"
+	CountryTag            = "country"
+	CountryCodeTag        = "country_code"
+	CountryCodeAlpha3Tag  = "country_code_alpha3"
+	FormattedAddressTag   = "formatted_address"
+	RealAddressTag        = "real_address"
+	LatLngTag             = "lat_lng"
+	GeoJSONPointTag       = "geojson_point"
+	GeoJSONLineStringTag  = "geojson_linestring"
+	GeoJSONPolygonTag     = "geojson_polygon"
 	CreditCardNumber      = "cc_number"
 	CreditCardType        = "cc_type"
+	CreditCardExpiry      = "cc_expiry"
+	CreditCardCVV         = "cc_cvv"
+	CreditCardHolder      = "cc_holder"
+	CreditCardTag         = "credit_card"
+	IBANTag               = "iban"
+	BICTag                = "bic"
+	ABARoutingNumberTag   = "aba_routing_number"
+	UKSortCodeTag         = "uk_sort_code"
+	UKAccountNumberTag    = "uk_account_number"
 	PhoneNumber           = "phone_number"
 	TollFreeNumber        = "toll_free_number"
 	E164PhoneNumberTag    = "e_164_phone_number"
@@ -74,6 +117,8 @@ const (
 	FirstNameFemaleTag    = "first_name_female"
 	LastNameTag           = "last_name"
 	NAME                  = "name"
+	BirthdateTag          = "birthdate"
+	AgeTag                = "age"
 	UnixTimeTag           = "unix_time"
 	DATE                  = "date"
 	TIME                  = "time"
@@ -91,13 +136,37 @@ const (
 	CurrencyTag           = "currency"
 	AmountTag             = "amount"
 	AmountWithCurrencyTag = "amount_with_currency"
+	FormattedAmountTag    = "formatted_amount"
+	MoneyTag              = "money"
+	DatasetTag            = "dataset"
 	SKIP                  = "-"
 	Length                = "len"
 	BoundaryStart         = "boundary_start"
 	BoundaryEnd           = "boundary_end"
 	Equals                = "="
 	Use                   = "use"
+	From                  = "from"
+	After                 = "after"
+	Near                  = "near"
+	RadiusKm              = "radius_km"
+	BBox                  = "bbox"
+	CountryParam          = "country"
+	LineParam             = "line"
+	NetworkParam          = "network"
+	CurrencyParam         = "currency"
+	MinParam              = "min"
+	MaxParam              = "max"
+	NamespaceParam        = "namespace"
+	NameParam             = "name"
+	SizeParam             = "size"
+	BeforeParam           = "before"
+	WithinParam           = "within"
+	PastParam             = "past"
+	FutureParam           = "future"
+	MinAgeParam           = "min_age"
+	MaxAgeParam           = "max_age"
 	comma                 = ","
+	plus                  = "+"
 )
 
 var defaultTag = map[string]string{
@@ -111,8 +180,34 @@ var defaultTag = map[string]string{
 	PASSWORD:              PASSWORD,
 	CreditCardType:        CreditCardType,
 	CreditCardNumber:      CreditCardNumber,
+	CreditCardExpiry:      CreditCardExpiry,
+	CreditCardCVV:         CreditCardCVV,
+	CreditCardHolder:      CreditCardHolder,
+	CreditCardTag:         CreditCardTag,
+	IBANTag:               IBANTag,
+	BICTag:                BICTag,
+	ABARoutingNumberTag:   ABARoutingNumberTag,
+	UKSortCodeTag:         UKSortCodeTag,
+	UKAccountNumberTag:    UKAccountNumberTag,
 	LATITUDE:              LATITUDE,
 	LONGITUDE:             LONGITUDE,
+	StreetAddressTag:      StreetAddressTag,
+	StreetNameTag:         StreetNameTag,
+	BuildingNumberTag:     BuildingNumberTag,
+	SecondaryAddressTag:   SecondaryAddressTag,
+	CityTag:               CityTag,
+	StateTag:              StateTag,
+	StateAbbrTag:          StateAbbrTag,
+	PostcodeTag:           PostcodeTag,
+	CountryTag:            CountryTag,
+	CountryCodeTag:        CountryCodeTag,
+	CountryCodeAlpha3Tag:  CountryCodeAlpha3Tag,
+	FormattedAddressTag:   FormattedAddressTag,
+	RealAddressTag:        RealAddressTag,
+	LatLngTag:             LatLngTag,
+	GeoJSONPointTag:       GeoJSONPointTag,
+	GeoJSONLineStringTag:  GeoJSONLineStringTag,
+	GeoJSONPolygonTag:     GeoJSONPolygonTag,
 	PhoneNumber:           PhoneNumber,
 	TollFreeNumber:        TollFreeNumber,
 	E164PhoneNumberTag:    E164PhoneNumberTag,
@@ -123,6 +218,8 @@ var defaultTag = map[string]string{
 	FirstNameFemaleTag:    FirstNameFemaleTag,
 	LastNameTag:           LastNameTag,
 	NAME:                  NAME,
+	BirthdateTag:          BirthdateTag,
+	AgeTag:                AgeTag,
 	UnixTimeTag:           UnixTimeTag,
 	DATE:                  DATE,
 	TIME:                  TimeFormat,
@@ -140,57 +237,69 @@ var defaultTag = map[string]string{
 	CurrencyTag:           CurrencyTag,
 	AmountTag:             AmountTag,
 	AmountWithCurrencyTag: AmountWithCurrencyTag,
+	FormattedAmountTag:    FormattedAmountTag,
+	MoneyTag:              MoneyTag,
+	DatasetTag:            DatasetTag,
 	ID:                    ID,
 	HyphenatedID:          HyphenatedID,
+	UUIDV1Tag:             UUIDV1Tag,
+	UUIDV3Tag:             UUIDV3Tag,
+	UUIDV5Tag:             UUIDV5Tag,
+	UUIDV6Tag:             UUIDV6Tag,
+	UUIDV7Tag:             UUIDV7Tag,
+	ULIDTag:               ULIDTag,
+	KSUIDTag:              KSUIDTag,
+	ObjectIDTag:           ObjectIDTag,
+	SnowflakeTag:          SnowflakeTag,
+	NanoIDTag:             NanoIDTag,
+}
+
+// tagParams are the parameters of the built-in providers written in the tags, e.g. `faker:"lat,near=52.52:13.40,radius_km=5"`
+var tagParams = map[string]bool{
+	Near:           true,
+	RadiusKm:       true,
+	BBox:           true,
+	CountryParam:   true,
+	LineParam:      true,
+	NetworkParam:   true,
+	CurrencyParam:  true,
+	MinParam:       true,
+	MaxParam:       true,
+	NamespaceParam: true,
+	NameParam:      true,
+	SizeParam:      true,
+	BeforeParam:    true,
+	WithinParam:    true,
+	MinAgeParam:    true,
+	MaxAgeParam:    true,
+}
+
+// tagFlags are the parameters of the built-in providers written alone in the tags, e.g. `faker:"date,past"`
+var tagFlags = map[string]bool{
+	PastParam:   true,
+	FutureParam: true,
+}
+
+// AfterFaker is implemented by structs that need to fix up their fake data, e.g. to compute totals or checksums.
+// AfterFake is called once all the fields of the struct are filled, for nested structs and slice elements too.
+// A returned error stops the generation and is returned by FakeData.
+type AfterFaker interface {
+	AfterFake() error
 }
 
 // TaggedFunction used as the standard layout function for tag providers in struct.
 // This type also can be used for custom provider.
 type TaggedFunction func(v reflect.Value) (interface{}, error)
 
-var mapperTag = map[string]TaggedFunction{
-	EmailTag:              GetNetworker().Email,
-	MacAddressTag:         GetNetworker().MacAddress,
-	DomainNameTag:         GetNetworker().DomainName,
-	URLTag:                GetNetworker().URL,
-	UserNameTag:           GetNetworker().UserName,
-	IPV4Tag:               GetNetworker().IPv4,
-	IPV6Tag:               GetNetworker().IPv6,
-	PASSWORD:              GetNetworker().Password,
-	CreditCardType:        GetPayment().CreditCardType,
-	CreditCardNumber:      GetPayment().CreditCardNumber,
-	LATITUDE:              GetAddress().Latitude,
-	LONGITUDE:             GetAddress().Longitude,
-	PhoneNumber:           GetPhoner().PhoneNumber,
-	TollFreeNumber:        GetPhoner().TollFreePhoneNumber,
-	E164PhoneNumberTag:    GetPhoner().E164PhoneNumber,
-	TitleMaleTag:          GetPerson().TitleMale,
-	TitleFemaleTag:        GetPerson().TitleFeMale,
-	FirstNameTag:          GetPerson().FirstName,
-	FirstNameMaleTag:      GetPerson().FirstNameMale,
-	FirstNameFemaleTag:    GetPerson().FirstNameFemale,
-	LastNameTag:           GetPerson().LastName,
-	NAME:                  GetPerson().Name,
-	UnixTimeTag:           GetDateTimer().UnixTime,
-	DATE:                  GetDateTimer().Date,
-	TIME:                  GetDateTimer().Time,
-	MonthNameTag:          GetDateTimer().MonthName,
-	YEAR:                  GetDateTimer().Year,
-	DayOfWeekTag:          GetDateTimer().DayOfWeek,
-	DayOfMonthTag:         GetDateTimer().DayOfMonth,
-	TIMESTAMP:             GetDateTimer().Timestamp,
-	CENTURY:               GetDateTimer().Century,
-	TIMEZONE:              GetDateTimer().TimeZone,
-	TimePeriodTag:         GetDateTimer().TimePeriod,
-	WORD:                  GetLorem().Word,
-	SENTENCE:              GetLorem().Sentence,
-	PARAGRAPH:             GetLorem().Paragraph,
-	CurrencyTag:           GetPrice().Currency,
-	AmountTag:             GetPrice().Amount,
-	AmountWithCurrencyTag: GetPrice().AmountWithCurrency,
-	ID:                    GetIdentifier().Digit,
-	HyphenatedID:          GetIdentifier().Hyphenated,
-}
+// ContextTaggedFunction is the layout function for tag providers receiving the context given to FakeDataContext,
+// or context.Background() when the value is generated with FakeData.
+type ContextTaggedFunction func(ctx context.Context, v reflect.Value) (interface{}, error)
+
+// mapperTag holds the providers added with AddProvider, the built-in ones are in builtinTags
+var mapperTag = map[string]TaggedFunction{}
+
+// mapperContextTag holds the providers added with AddContextProvider
+var mapperContextTag = map[string]ContextTaggedFunction{}
 
 // Generic Error Messages for tags
 // 		ErrUnsupportedKindPtr: Error when get fake from ptr
@@ -209,12 +318,35 @@ var (
 	ErrMoreArguments       = "Passed more arguments than is possible : (%d)"
 	ErrNotSupportedPointer = "Use sample:=new(%s)\n faker.FakeData(sample) instead"
 	ErrSmallerThanZero     = "Size:%d is smaller than zero."
+	ErrSmallerThanOne      = "Size:%d is smaller than one."
 	ErrUniqueFailure       = "Failed to generate a unique value for field \"%s\""
 
 	ErrStartValueBiggerThanEnd = "Start value can not be bigger than end value."
 	ErrWrongFormattedTag       = "Tag \"%s\" is not written properly"
 	ErrUnknownType             = "Unknown Type"
 	ErrNotSupportedTypeForTag  = "Type is not supported by tag."
+
+	ErrWrongFalsePositiveRate = "False positive rate must be between 0 and 1."
+	ErrUnknownDependency      = "Field \"%s\" depends on unknown field \"%s\""
+	ErrCyclicDependency       = "Cyclic dependency between the fields of %s"
+	ErrWrongWithFunc          = "With expects a func(%s), got %v"
+	ErrWrongOverrideType      = "Override \"%s\": can't use %#v as %s"
+	ErrOverrideNotFound       = "Overrides %v do not match any field"
+	ErrWrongFactoryType       = "Factory \"%s\" builds %v, got %T"
+	ErrTraitNotFound          = "Trait \"%s\" is not defined in factory \"%s\""
+	ErrUniqueExhausted        = "All the %d possible values of \"%s\" were already generated"
+	ErrWrongManyType          = "FakeMany expects a pointer to a slice, got %T"
+	ErrUnknownLocale          = "Unknown locale \"%s\""
+	ErrUnknownDataset         = "Unknown dataset \"%s\""
+	ErrUnknownDatasetFormat   = "Unknown format of dataset file \"%s\""
+	ErrEmptyDataset           = "Dataset \"%s\" has no values"
+	ErrWrongDatasetLine       = "Dataset \"%s\": invalid line %d"
+	ErrDatasetColumnNotFound  = "Dataset \"%s\": column \"%s\" not found"
+	ErrUnknownCountry         = "Unknown country \"%s\""
+	ErrUnknownCardNetwork     = "Unknown card network \"%s\""
+	ErrUnsupportedCountry     = "Unsupported country \"%s\""
+	ErrUnknownCurrency        = "Unknown currency \"%s\""
+	ErrAmountOverflow         = "Amount of %d minor units overflows %s"
 )
 
 func init() {
@@ -228,17 +360,46 @@ func SetSeed(seed int64) {
 
 // ResetUnique is used to forget generated unique values.
 // Call this when you're done generating a dataset.
-func ResetUnique() {
-	uniqueValues = map[string][]interface{}{}
+// When scopes are given, only the values of these scopes are forgotten: the ones named with "unique=scope" tags
+// or the "package/path.Type.Field" default scope of a struct field, e.g. "github.com/acme/models.User.Email".
+func ResetUnique(scopes ...string) {
+	uniqueMu.Lock()
+	defer uniqueMu.Unlock()
+
+	if len(scopes) == 0 {
+		uniqueValues = map[string]uniqueSet{}
+		return
+	}
+	for _, scope := range scopes {
+		delete(uniqueValues, scope)
+	}
 }
 
 // SetGenerateUniqueValues allows to set the single fake data generator functions to generate unique data.
 func SetGenerateUniqueValues(unique bool) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
 	generateUniqueValues = unique
 }
 
+// SetMaxRetry sets how many times a unique value is generated again before giving up, 10000 by default
+func SetMaxRetry(n int) error {
+	if n < 1 {
+		return fmt.Errorf(ErrSmallerThanOne, n)
+	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
+	maxRetry = n
+	return nil
+}
+
 // SetNilIfLenIsZero allows to set nil for the slice and maps, if size is 0.
 func SetNilIfLenIsZero(setNil bool) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
 	shouldSetNil = setNil
 }
 
@@ -247,6 +408,9 @@ func SetRandomStringLength(size int) error {
 	if size < 0 {
 		return fmt.Errorf(ErrSmallerThanZero, size)
 	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
 	randomStringLen = size
 	return nil
 }
@@ -256,6 +420,9 @@ func SetFixedMapAndSliceSize(size int) error {
 	if size < 0 {
 		return fmt.Errorf(ErrSmallerThanZero, size)
 	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
 	randomSize = size
 	isFixedSize = true
 	return nil
@@ -266,6 +433,9 @@ func SetRandomMapAndSliceSize(size int) error {
 	if size < 0 {
 		return fmt.Errorf(ErrSmallerThanZero, size)
 	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
 	randomSize = size
 	isFixedSize = false
 	return nil
@@ -276,13 +446,49 @@ func SetRandomNumberBoundaries(start, end int) error {
 	if start > end {
 		return errors.New(ErrStartValueBiggerThanEnd)
 	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
 	nBoundary = numberBoundary{start: start, end: end}
 	return nil
 }
 
+// nilIfLenIsZero tells if empty slices and maps are set to nil, see SetNilIfLenIsZero
+func nilIfLenIsZero() bool {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+
+	return shouldSetNil
+}
+
+// stringLength returns the length of random strings, see SetRandomStringLength
+func stringLength() int {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+
+	return randomStringLen
+}
+
+// numberBoundaries returns the boundaries of random numbers, see SetRandomNumberBoundaries
+func numberBoundaries() numberBoundary {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+
+	return nBoundary
+}
+
+// retryBudget returns how many times a unique value is generated before giving up, see SetMaxRetry
+func retryBudget() int {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+
+	return maxRetry
+}
+
 // FakeData is the main function. Will generate a fake data based on your struct.  You can use this for automation testing, or anything that need automated data.
 // You don't need to Create your own data for your testing.
-func FakeData(a interface{}) error {
+// Options such as With and Override can be passed to control parts of the generated value.
+func FakeData(a interface{}, opt ...Option) error {
 
 	reflectType := reflect.TypeOf(a)
 
@@ -294,14 +500,45 @@ func FakeData(a interface{}) error {
 		return fmt.Errorf(ErrNotSupportedPointer, reflectType.Elem().String())
 	}
 
+	return fakeData(a, newOptions(opt...))
+}
+
+// FakeDataContext is FakeData stopping with the error of ctx as soon as it is done, the context is checked between
+// the fields of structs and the elements of slices and maps. ctx is passed to the providers added with AddContextProvider.
+func FakeDataContext(ctx context.Context, a interface{}, opt ...Option) error {
+	reflectType := reflect.TypeOf(a)
+
+	if reflectType.Kind() != reflect.Ptr {
+		return errors.New(ErrValueNotPtr)
+	}
+
+	if reflect.ValueOf(a).IsNil() {
+		return fmt.Errorf(ErrNotSupportedPointer, reflectType.Elem().String())
+	}
+
+	opts := newOptions(opt...)
+	opts.ctx = ctx
+	return fakeData(a, opts)
+}
+
+func fakeData(a interface{}, opts *options) error {
+	reflectType := reflect.TypeOf(a)
+	if err := opts.validate(reflectType); err != nil {
+		return err
+	}
+
 	rval := reflect.ValueOf(a)
 
-	finalValue, err := getValue(a)
+	finalValue, err := getValue(a, opts, "")
 	if err != nil {
 		return err
 	}
+	if err := opts.unused(); err != nil {
+		return err
+	}
 
 	rval.Elem().Set(finalValue.Elem().Convert(reflectType.Elem()))
+	opts.applyWith(rval)
 	return nil
 }
 
@@ -349,21 +586,57 @@ func FakeData(a interface{}) error {
 // 		{ID:43 Gondoruwo:{Name:Power Locatadata:324} Danger:danger-ranger}
 // Notes: when using a custom provider make sure to return the same type as the field
 func AddProvider(tag string, provider TaggedFunction) error {
+	mapperMu.Lock()
+	defer mapperMu.Unlock()
+
+	if _, ok := builtinTags[tag]; ok {
+		return errors.New(ErrTagAlreadyExists)
+	}
 	if _, ok := mapperTag[tag]; ok {
 		return errors.New(ErrTagAlreadyExists)
 	}
+	if _, ok := mapperContextTag[tag]; ok {
+		return errors.New(ErrTagAlreadyExists)
+	}
 
 	mapperTag[tag] = provider
 
 	return nil
 }
 
-func getValue(a interface{}) (reflect.Value, error) {
+// AddContextProvider is AddProvider for providers using the context of the generation,
+// e.g. to read request scoped values such as a tenant ID:
+//
+//	faker.AddContextProvider("tenant", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+//		return ctx.Value(tenantKey{}), nil
+//	})
+//	err := faker.FakeDataContext(context.WithValue(ctx, tenantKey{}, "acme"), &sample)
+func AddContextProvider(tag string, provider ContextTaggedFunction) error {
+	mapperMu.Lock()
+	defer mapperMu.Unlock()
+
+	if _, ok := builtinTags[tag]; ok {
+		return errors.New(ErrTagAlreadyExists)
+	}
+	if _, ok := mapperTag[tag]; ok {
+		return errors.New(ErrTagAlreadyExists)
+	}
+	if _, ok := mapperContextTag[tag]; ok {
+		return errors.New(ErrTagAlreadyExists)
+	}
+
+	mapperContextTag[tag] = provider
+
+	return nil
+}
+
+func getValue(a interface{}, opts *options, path string) (reflect.Value, error) {
 	t := reflect.TypeOf(a)
 	if t == nil {
 		return reflect.Value{}, fmt.Errorf("interface{} not allowed")
 	}
 	k := t.Kind()
+	r := opts.provider().rnd()
 
 	switch k {
 	case reflect.Ptr:
@@ -371,12 +644,12 @@ func getValue(a interface{}) (reflect.Value, error) {
 		var val reflect.Value
 		var err error
 		if a != reflect.Zero(reflect.TypeOf(a)).Interface() {
-			val, err = getValue(reflect.ValueOf(a).Elem().Interface())
+			val, err = getValue(reflect.ValueOf(a).Elem().Interface(), opts, path)
 			if err != nil {
 				return reflect.Value{}, err
 			}
 		} else {
-			val, err = getValue(v.Elem().Interface())
+			val, err = getValue(v.Elem().Interface(), opts, path)
 			if err != nil {
 				return reflect.Value{}, err
 			}
@@ -386,83 +659,55 @@ func getValue(a interface{}) (reflect.Value, error) {
 	case reflect.Struct:
 		switch t.String() {
 		case "time.Time":
-			ft := time.Now().Add(time.Duration(rand.Int63()))
+			d := DateTime{opts.provider()}
+			if d.hasTimeRange() {
+				ft, err := d.randomTime()
+				return reflect.ValueOf(ft), err
+			}
+			ft := d.currentTime().Add(time.Duration(r.Int63()))
 			return reflect.ValueOf(ft), nil
 		default:
 			originalDataVal := reflect.ValueOf(a)
 			v := reflect.New(t).Elem()
-			retry := 0 // error if cannot generate unique value after maxRetry tries
-			for i := 0; i < v.NumField(); i++ {
-				if !v.Field(i).CanSet() {
-					continue // to avoid panic to set on unexported field in struct
-				}
-				tags := decodeTags(t, i)
-				switch {
-				case tags.keepOriginal:
-					zero, err := isZero(reflect.ValueOf(a).Field(i))
-					if err != nil {
-						return reflect.Value{}, err
-					}
-					if zero {
-						err := setDataWithTag(v.Field(i).Addr(), tags.fieldType)
-						if err != nil {
-							return reflect.Value{}, err
-						}
-						continue
-					}
-					v.Field(i).Set(reflect.ValueOf(a).Field(i))
-				case tags.fieldType == "":
-					val, err := getValue(v.Field(i).Interface())
-					if err != nil {
-						return reflect.Value{}, err
-					}
-					val = val.Convert(v.Field(i).Type())
-					v.Field(i).Set(val)
-				case tags.fieldType == SKIP:
-					item := originalDataVal.Field(i).Interface()
-					if v.CanSet() && item != nil {
-						v.Field(i).Set(reflect.ValueOf(item))
-					}
-				default:
-					err := setDataWithTag(v.Field(i).Addr(), tags.fieldType)
-					if err != nil {
-						return reflect.Value{}, err
-					}
-				}
-
-				if tags.unique {
-
-					if retry >= maxRetry {
-						return reflect.Value{}, fmt.Errorf(ErrUniqueFailure, reflect.TypeOf(a).Field(i).Name)
-					}
-
-					value := v.Field(i).Interface()
-					if slice.ContainsValue(uniqueValues[tags.fieldType], value) { // Retry if unique value already found
-						i--
-						retry++
-						continue
-					}
-					retry = 0
-					uniqueValues[tags.fieldType] = append(uniqueValues[tags.fieldType], value)
-				} else {
-					retry = 0
-				}
-
+			tags := make([]structTag, v.NumField())
+			for i := range tags {
+				tags[i] = decodeTags(t, i)
+			}
+			order, err := fieldOrder(t, tags)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			// the card fields of the struct share their network, nested structs have their own
+			card := opts.card
+			opts.card = &creditCard{}
+			err = uniqueTogether(v, originalDataVal, tags, order, opts, path)
+			opts.card = card
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			if path == "" {
+				opts.applyWith(v.Addr())
+			}
+			if err := afterFake(v); err != nil {
+				return reflect.Value{}, err
 			}
 			return v, nil
 		}
 
 	case reflect.String:
-		res := randomString(randomStringLen)
+		res := randomString(r, stringLength())
 		return reflect.ValueOf(res), nil
 	case reflect.Array, reflect.Slice:
-		len := randomSliceAndMapSize()
-		if shouldSetNil && len == 0 {
+		len := opts.sliceLen(path, randomSliceAndMapSize(r))
+		if nilIfLenIsZero() && len == 0 {
 			return reflect.Zero(t), nil
 		}
 		v := reflect.MakeSlice(t, len, len)
 		for i := 0; i < v.Len(); i++ {
-			val, err := getValue(v.Index(i).Interface())
+			if err := opts.err(); err != nil {
+				return reflect.Value{}, err
+			}
+			val, err := getValue(v.Index(i).Interface(), opts, indexPath(path, i))
 			if err != nil {
 				return reflect.Value{}, err
 			}
@@ -470,53 +715,56 @@ func getValue(a interface{}) (reflect.Value, error) {
 		}
 		return v, nil
 	case reflect.Int:
-		return reflect.ValueOf(randomInteger()), nil
+		return reflect.ValueOf(randomInteger(r)), nil
 	case reflect.Int8:
-		return reflect.ValueOf(int8(randomInteger())), nil
+		return reflect.ValueOf(int8(randomInteger(r))), nil
 	case reflect.Int16:
-		return reflect.ValueOf(int16(randomInteger())), nil
+		return reflect.ValueOf(int16(randomInteger(r))), nil
 	case reflect.Int32:
-		return reflect.ValueOf(int32(randomInteger())), nil
+		return reflect.ValueOf(int32(randomInteger(r))), nil
 	case reflect.Int64:
-		return reflect.ValueOf(int64(randomInteger())), nil
+		return reflect.ValueOf(int64(randomInteger(r))), nil
 	case reflect.Float32:
-		return reflect.ValueOf(rand.Float32()), nil
+		return reflect.ValueOf(r.Float32()), nil
 	case reflect.Float64:
-		return reflect.ValueOf(rand.Float64()), nil
+		return reflect.ValueOf(r.Float64()), nil
 	case reflect.Bool:
-		val := rand.Intn(2) > 0
+		val := r.Intn(2) > 0
 		return reflect.ValueOf(val), nil
 
 	case reflect.Uint:
-		return reflect.ValueOf(uint(randomInteger())), nil
+		return reflect.ValueOf(uint(randomInteger(r))), nil
 
 	case reflect.Uint8:
-		return reflect.ValueOf(uint8(randomInteger())), nil
+		return reflect.ValueOf(uint8(randomInteger(r))), nil
 
 	case reflect.Uint16:
-		return reflect.ValueOf(uint16(randomInteger())), nil
+		return reflect.ValueOf(uint16(randomInteger(r))), nil
 
 	case reflect.Uint32:
-		return reflect.ValueOf(uint32(randomInteger())), nil
+		return reflect.ValueOf(uint32(randomInteger(r))), nil
 
 	case reflect.Uint64:
-		return reflect.ValueOf(uint64(randomInteger())), nil
+		return reflect.ValueOf(uint64(randomInteger(r))), nil
 
 	case reflect.Map:
-		len := randomSliceAndMapSize()
-		if shouldSetNil && len == 0 {
+		len := randomSliceAndMapSize(r)
+		if nilIfLenIsZero() && len == 0 {
 			return reflect.Zero(t), nil
 		}
 		v := reflect.MakeMap(t)
 		for i := 0; i < len; i++ {
+			if err := opts.err(); err != nil {
+				return reflect.Value{}, err
+			}
 			keyInstance := reflect.New(t.Key()).Elem().Interface()
-			key, err := getValue(keyInstance)
+			key, err := getValue(keyInstance, opts, path)
 			if err != nil {
 				return reflect.Value{}, err
 			}
 
 			valueInstance := reflect.New(t.Elem()).Elem().Interface()
-			val, err := getValue(valueInstance)
+			val, err := getValue(valueInstance, opts, fmt.Sprintf("%s[%v]", path, key.Interface()))
 			if err != nil {
 				return reflect.Value{}, err
 			}
@@ -530,10 +778,159 @@ func getValue(a interface{}) (reflect.Value, error) {
 
 }
 
+// setFields fills the fields of the struct v in the given order, original holds the value passed to FakeData
+func setFields(v, original reflect.Value, tags []structTag, order []int, opts *options, path string) error {
+	t := v.Type()
+	retry := 0 // error if cannot generate unique value after maxRetry tries
+	maxRetry := retryBudget()
+	for j := 0; j < len(order); j++ {
+		i := order[j]
+		if err := opts.err(); err != nil {
+			return err
+		}
+		if !v.Field(i).CanSet() {
+			continue // to avoid panic to set on unexported field in struct
+		}
+		tags := tags[i]
+		opts.params = tags.params
+		fieldPath := fieldPath(path, t.Field(i).Name)
+		overridden, err := opts.override(v.Field(i), fieldPath)
+		if err != nil {
+			return err
+		}
+		if overridden {
+			retry = 0
+			continue
+		}
+		if opts.merge {
+			merged, err := mergeField(v.Field(i), original.Field(i), tags, opts, fieldPath)
+			if err != nil {
+				return err
+			}
+			if merged {
+				retry = 0
+				continue
+			}
+		}
+		if tags.keepOriginal && !opts.merge { // merging already kept the non zero values
+			zero, err := isZero(original.Field(i))
+			if err != nil {
+				return err
+			}
+			if !zero {
+				// kept values are not generated, like the merged ones, so they are not claimed as unique
+				v.Field(i).Set(original.Field(i))
+				retry = 0
+				continue
+			}
+		}
+		card := *opts.card
+		switch {
+		case tags.derived():
+			err := setDerivedValue(v, i, tags, opts.provider())
+			if err != nil {
+				return err
+			}
+		case tags.fieldType == "":
+			val, err := getValue(v.Field(i).Interface(), opts, fieldPath)
+			if err != nil {
+				return err
+			}
+			val = val.Convert(v.Field(i).Type())
+			v.Field(i).Set(val)
+		case tags.fieldType == SKIP:
+			item := original.Field(i).Interface()
+			if v.CanSet() && item != nil {
+				v.Field(i).Set(reflect.ValueOf(item))
+			}
+		default:
+			err := setDataWithTag(v.Field(i).Addr(), tags.fieldType, opts)
+			if err != nil {
+				return err
+			}
+		}
+
+		if tags.unique {
+
+			if retry >= maxRetry {
+				return fmt.Errorf(ErrUniqueFailure, t.Field(i).Name)
+			}
+
+			scope := tags.uniqueScope
+			if scope == "" {
+				scope = typeScope(t, t.Field(i).Name)
+			}
+			value := v.Field(i).Interface()
+			if tags.derived() {
+				// generating the field again gives the same value, uniqueTogether generates its sources again instead
+				opts.held[i] = uniqueClaim{scope: scope, value: value}
+				retry = 0
+				continue
+			}
+			if !opts.claimField(i, scope, value) { // Retry if unique value already found
+				if n, ok := domainSize(tags.fieldType, v.Field(i).Type(), opts.provider()); ok && uniqueLen(scope) >= n {
+					return fmt.Errorf(ErrUniqueExhausted, n, t.Field(i).Name)
+				}
+				// the card network chosen for the rejected value is chosen again, see Payment.network
+				*opts.card = card
+				j--
+				retry++
+				continue
+			}
+			retry = 0
+		} else {
+			retry = 0
+		}
+
+	}
+	return nil
+}
+
+// afterFake calls the AfterFake hook of the freshly generated struct v, if it has one
+func afterFake(v reflect.Value) error {
+	if hook, ok := v.Addr().Interface().(AfterFaker); ok {
+		return hook.AfterFake()
+	}
+	return nil
+}
+
+// mergeField sets field from its original value when the latter is not zero. Untagged structs, directly or behind
+// a pointer, are merged recursively so only their zero valued fields get faked.
+func mergeField(field, original reflect.Value, tags structTag, opts *options, path string) (bool, error) {
+	if isZeroValue(original) {
+		return false, nil
+	}
+	if tags.fieldType == "" && original.Type() != timeType &&
+		(original.Kind() == reflect.Struct || original.Kind() == reflect.Ptr && original.Elem().Kind() == reflect.Struct) {
+		val, err := getValue(original.Interface(), opts, path)
+		if err != nil {
+			return false, err
+		}
+		field.Set(val.Convert(field.Type()))
+		return true, nil
+	}
+	field.Set(original)
+	return true, nil
+}
+
+// isZeroValue tells if v holds the zero value of its type, slices and maps are zero when they are empty
+func isZeroValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
 func isZero(field reflect.Value) (bool, error) {
 	if field.Kind() == reflect.Map {
 		return field.Len() == 0, nil
 	}
+	if field.Type() == timeType {
+		return field.Interface().(time.Time).IsZero(), nil
+	}
 
 	for _, kind := range []reflect.Kind{reflect.Struct, reflect.Slice, reflect.Array} {
 		if kind == field.Kind() {
@@ -548,14 +945,55 @@ func decodeTags(typ reflect.Type, i int) structTag {
 
 	keepOriginal := false
 	uni := false
+	uniqueScope := ""
+	var from []string
+	var after string
+	var params map[string]string
+	setParam := func(name, value string) {
+		if params == nil {
+			params = map[string]string{}
+		}
+		params[name] = value
+	}
 	res := make([]string, 0)
 	for _, tag := range tags {
+		if kv := strings.SplitN(tag, Equals, 2); len(kv) == 2 && tagParams[kv[0]] {
+			setParam(kv[0], kv[1])
+			continue
+		}
+		if tagFlags[tag] {
+			setParam(tag, "")
+			continue
+		}
 		if tag == keep {
 			keepOriginal = true
 			continue
 		} else if tag == unique {
 			uni = true
 			continue
+		} else if strings.HasPrefix(tag, unique+Equals) {
+			uni = true
+			uniqueScope = strings.TrimPrefix(tag, unique+Equals)
+			continue
+		} else if strings.HasPrefix(tag, DatasetTag+Equals) {
+			// the name of the dataset is the parameter of the dataset provider
+			setParam(DatasetTag, strings.TrimPrefix(tag, DatasetTag+Equals))
+			res = append(res, DatasetTag)
+			continue
+		} else if strings.HasPrefix(tag, From+Equals) {
+			from = strings.Split(strings.TrimPrefix(tag, From+Equals), plus)
+			continue
+		} else if strings.HasPrefix(tag, After+Equals) {
+			// after names a sibling field, or else is the date the time range parameters start from
+			name := strings.TrimPrefix(tag, After+Equals)
+			if _, ok := typ.FieldByName(name); !ok {
+				if _, ok := parseDate(name); ok {
+					setParam(After, name)
+					continue
+				}
+			}
+			after = name
+			continue
 		}
 		res = append(res, tag)
 	}
@@ -563,17 +1001,38 @@ func decodeTags(typ reflect.Type, i int) structTag {
 	return structTag{
 		fieldType:    strings.Join(res, ","),
 		unique:       uni,
+		uniqueScope:  uniqueScope,
 		keepOriginal: keepOriginal,
+		from:         from,
+		after:        after,
+		params:       params,
 	}
 }
 
 type structTag struct {
 	fieldType    string
 	unique       bool
+	uniqueScope  string
 	keepOriginal bool
+	from         []string
+	after        string
+	params       map[string]string
+}
+
+// derived tells if the field value is computed from sibling fields
+func (s structTag) derived() bool {
+	return len(s.from) > 0 || s.after != ""
+}
+
+// dependencies returns the names of the sibling fields that have to be generated first
+func (s structTag) dependencies() []string {
+	if s.after != "" {
+		return append([]string{s.after}, s.from...)
+	}
+	return s.from
 }
 
-func setDataWithTag(v reflect.Value, tag string) error {
+func setDataWithTag(v reflect.Value, tag string, opts *options) error {
 	if v.Kind() != reflect.Ptr {
 		return errors.New(ErrValueNotPtr)
 	}
@@ -583,7 +1042,7 @@ func setDataWithTag(v reflect.Value, tag string) error {
 		if strings.Contains(tag, Use) {
 			t := v.Type()
 			newv := reflect.New(t.Elem()).Elem()
-			err := setDataWithTagSwitch(newv, tag)
+			err := setDataWithTagSwitch(newv, tag, opts)
 			if err != nil {
 				return err
 			}
@@ -591,11 +1050,12 @@ func setDataWithTag(v reflect.Value, tag string) error {
 			return nil
 		}
 
-		if _, exist := mapperTag[tag]; !exist {
+		tagFunc, exist := opts.tagFunction(tag)
+		if !exist {
 			return fmt.Errorf(ErrTagNotSupported, tag)
 		}
 		if _, def := defaultTag[tag]; !def {
-			res, err := mapperTag[tag](v)
+			res, err := tagFunc(v)
 			if err != nil {
 				return err
 			}
@@ -605,7 +1065,7 @@ func setDataWithTag(v reflect.Value, tag string) error {
 
 		t := v.Type()
 		newv := reflect.New(t.Elem())
-		res, err := mapperTag[tag](newv.Elem())
+		res, err := tagFunc(newv.Elem())
 		if err != nil {
 			return err
 		}
@@ -614,28 +1074,29 @@ func setDataWithTag(v reflect.Value, tag string) error {
 		v.Set(newv)
 		return nil
 	default:
-		return setDataWithTagSwitch(v, tag)
+		return setDataWithTagSwitch(v, tag, opts)
 	}
 }
 
-func setDataWithTagSwitch(v reflect.Value, tag string) error {
+func setDataWithTagSwitch(v reflect.Value, tag string, opts *options) error {
 	switch v.Kind() {
 	case reflect.String:
-		return userDefinedString(v, tag)
+		return userDefinedString(v, tag, opts)
 	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8,
 		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
-		return userDefinedNumber(v, tag)
+		return userDefinedNumber(v, tag, opts)
 	case reflect.Slice, reflect.Array:
-		return userDefinedArray(v, tag)
+		return userDefinedArray(v, tag, opts)
 	case reflect.Map:
-		return userDefinedMap(v, tag)
+		return userDefinedMap(v, tag, opts)
 	case reflect.Bool:
 		return userDefinedBool(v, tag)
 	default:
-		if _, exist := mapperTag[tag]; !exist {
+		tagFunc, exist := opts.tagFunction(tag)
+		if !exist {
 			return fmt.Errorf(ErrTagNotSupported, tag)
 		}
-		res, err := mapperTag[tag](v)
+		res, err := tagFunc(v)
 		if err != nil {
 			return err
 		}
@@ -662,8 +1123,8 @@ func userDefinedBool(v reflect.Value, tag string) error {
 	return nil
 }
 
-func userDefinedMap(v reflect.Value, tag string) error {
-	if tagFunc, ok := mapperTag[tag]; ok {
+func userDefinedMap(v reflect.Value, tag string, opts *options) error {
+	if tagFunc, ok := opts.tagFunction(tag); ok {
 		res, err := tagFunc(v)
 		if err != nil {
 			return err
@@ -673,18 +1134,21 @@ func userDefinedMap(v reflect.Value, tag string) error {
 		return nil
 	}
 
-	len := randomSliceAndMapSize()
-	if shouldSetNil && len == 0 {
+	len := randomSliceAndMapSize(opts.provider().rnd())
+	if nilIfLenIsZero() && len == 0 {
 		v.Set(reflect.Zero(v.Type()))
 		return nil
 	}
 	definedMap := reflect.MakeMap(v.Type())
 	for i := 0; i < len; i++ {
-		key, err := getValueWithTag(v.Type().Key(), tag)
+		if err := opts.err(); err != nil {
+			return err
+		}
+		key, err := getValueWithTag(v.Type().Key(), tag, opts.provider().rnd())
 		if err != nil {
 			return err
 		}
-		val, err := getValueWithTag(v.Type().Elem(), tag)
+		val, err := getValueWithTag(v.Type().Elem(), tag, opts.provider().rnd())
 		if err != nil {
 			return err
 		}
@@ -694,17 +1158,17 @@ func userDefinedMap(v reflect.Value, tag string) error {
 	return nil
 }
 
-func getValueWithTag(t reflect.Type, tag string) (interface{}, error) {
+func getValueWithTag(t reflect.Type, tag string, r *rand.Rand) (interface{}, error) {
 	switch t.Kind() {
 	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8,
 		reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		res, err := extractNumberFromTag(tag, t)
+		res, err := extractNumberFromTag(tag, t, r)
 		if err != nil {
 			return nil, err
 		}
 		return res, nil
 	case reflect.String:
-		res, err := extractStringFromTag(tag)
+		res, err := extractStringFromTag(tag, r)
 		if err != nil {
 			return nil, err
 		}
@@ -714,15 +1178,34 @@ func getValueWithTag(t reflect.Type, tag string) (interface{}, error) {
 	}
 }
 
-func userDefinedArray(v reflect.Value, tag string) error {
-	len := randomSliceAndMapSize()
-	if shouldSetNil && len == 0 {
+func userDefinedArray(v reflect.Value, tag string, opts *options) error {
+	if v.Kind() == reflect.Array {
+		// the arrays are filled as a whole, e.g. the [16]byte of the UUIDs
+		tagFunc, ok := opts.tagFunction(tag)
+		if !ok {
+			return fmt.Errorf(ErrTagNotSupported, tag)
+		}
+		res, err := tagFunc(v)
+		if err != nil {
+			return err
+		}
+		if rval := reflect.ValueOf(res); !rval.IsValid() || !rval.Type().AssignableTo(v.Type()) {
+			return errors.New(ErrNotSupportedTypeForTag)
+		}
+		v.Set(reflect.ValueOf(res))
+		return nil
+	}
+	len := randomSliceAndMapSize(opts.provider().rnd())
+	if nilIfLenIsZero() && len == 0 {
 		v.Set(reflect.Zero(v.Type()))
 		return nil
 	}
 	array := reflect.MakeSlice(v.Type(), len, len)
 	for i := 0; i < len; i++ {
-		res, err := getValueWithTag(v.Type().Elem(), tag)
+		if err := opts.err(); err != nil {
+			return err
+		}
+		res, err := getValueWithTag(v.Type().Elem(), tag, opts.provider().rnd())
 		if err != nil {
 			return err
 		}
@@ -732,17 +1215,17 @@ func userDefinedArray(v reflect.Value, tag string) error {
 	return nil
 }
 
-func userDefinedString(v reflect.Value, tag string) error {
+func userDefinedString(v reflect.Value, tag string, opts *options) error {
 	var res interface{}
 	var err error
 
-	if tagFunc, ok := mapperTag[tag]; ok {
+	if tagFunc, ok := opts.tagFunction(tag); ok {
 		res, err = tagFunc(v)
 		if err != nil {
 			return err
 		}
 	} else if strings.Contains(tag, Length) {
-		res, err = extractStringFromTag(tag)
+		res, err = extractStringFromTag(tag, opts.provider().rnd())
 		if err != nil {
 			return err
 		}
@@ -760,17 +1243,17 @@ func userDefinedString(v reflect.Value, tag string) error {
 	return nil
 }
 
-func userDefinedNumber(v reflect.Value, tag string) error {
+func userDefinedNumber(v reflect.Value, tag string, opts *options) error {
 	var res interface{}
 	var err error
 
-	if tagFunc, ok := mapperTag[tag]; ok {
+	if tagFunc, ok := opts.tagFunction(tag); ok {
 		res, err = tagFunc(v)
 		if err != nil {
 			return err
 		}
 	} else if strings.Contains(tag, BoundaryStart) {
-		res, err = extractNumberFromTag(tag, v.Type())
+		res, err = extractNumberFromTag(tag, v.Type(), opts.provider().rnd())
 		if err != nil {
 			return err
 		}
@@ -788,7 +1271,7 @@ func userDefinedNumber(v reflect.Value, tag string) error {
 	return nil
 }
 
-func extractStringFromTag(tag string) (interface{}, error) {
+func extractStringFromTag(tag string, r *rand.Rand) (interface{}, error) {
 	if !strings.Contains(tag, Length) {
 		return nil, fmt.Errorf(ErrTagNotSupported, tag)
 	}
@@ -796,7 +1279,7 @@ func extractStringFromTag(tag string) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	res := randomString(int(len))
+	res := randomString(r, int(len))
 	return res, nil
 }
 
@@ -857,7 +1340,7 @@ func extractNumberFromUseTag(tag string, t reflect.Type) (interface{}, error) {
 	}
 }
 
-func extractNumberFromTag(tag string, t reflect.Type) (interface{}, error) {
+func extractNumberFromTag(tag string, t reflect.Type, r *rand.Rand) (interface{}, error) {
 	if !strings.Contains(tag, BoundaryStart) || !strings.Contains(tag, BoundaryEnd) {
 		return nil, fmt.Errorf(ErrTagNotSupported, tag)
 	}
@@ -876,25 +1359,25 @@ func extractNumberFromTag(tag string, t reflect.Type) (interface{}, error) {
 	boundary := numberBoundary{start: int(startBoundary), end: int(endBoundary)}
 	switch t.Kind() {
 	case reflect.Uint:
-		return uint(randomIntegerWithBoundary(boundary)), nil
+		return uint(randomIntegerWithBoundary(r, boundary)), nil
 	case reflect.Uint8:
-		return uint8(randomIntegerWithBoundary(boundary)), nil
+		return uint8(randomIntegerWithBoundary(r, boundary)), nil
 	case reflect.Uint16:
-		return uint16(randomIntegerWithBoundary(boundary)), nil
+		return uint16(randomIntegerWithBoundary(r, boundary)), nil
 	case reflect.Uint32:
-		return uint32(randomIntegerWithBoundary(boundary)), nil
+		return uint32(randomIntegerWithBoundary(r, boundary)), nil
 	case reflect.Uint64:
-		return uint64(randomIntegerWithBoundary(boundary)), nil
+		return uint64(randomIntegerWithBoundary(r, boundary)), nil
 	case reflect.Int:
-		return randomIntegerWithBoundary(boundary), nil
+		return randomIntegerWithBoundary(r, boundary), nil
 	case reflect.Int8:
-		return int8(randomIntegerWithBoundary(boundary)), nil
+		return int8(randomIntegerWithBoundary(r, boundary)), nil
 	case reflect.Int16:
-		return int16(randomIntegerWithBoundary(boundary)), nil
+		return int16(randomIntegerWithBoundary(r, boundary)), nil
 	case reflect.Int32:
-		return int32(randomIntegerWithBoundary(boundary)), nil
+		return int32(randomIntegerWithBoundary(r, boundary)), nil
 	case reflect.Int64:
-		return int64(randomIntegerWithBoundary(boundary)), nil
+		return int64(randomIntegerWithBoundary(r, boundary)), nil
 	default:
 		return nil, errors.New(ErrNotSupportedTypeForTag)
 	}
@@ -922,104 +1405,21 @@ func extractStringFromText(text string) (string, error) {
 	return texts[1], nil
 }
 
-func randomString(n int) string {
-	b := make([]byte, n)
-	for i, cache, remain := n-1, rand.Int63(), letterIdxMax; i >= 0; {
-		if remain == 0 {
-			cache, remain = rand.Int63(), letterIdxMax
-		}
-		if idx := int(cache & letterIdxMask); idx < len(letterBytes) {
-			b[i] = letterBytes[idx]
-			i--
-		}
-		cache >>= letterIdxBits
-		remain--
-	}
-
-	return string(b)
-}
-
-// randomIntegerWithBoundary returns a random integer between input start and end boundary. [start, end)
-func randomIntegerWithBoundary(boundary numberBoundary) int {
-	return rand.Intn(boundary.end-boundary.start) + boundary.start
-}
-
-// randomInteger returns a random integer between start and end boundary. [start, end)
-func randomInteger() int {
-	return rand.Intn(nBoundary.end-nBoundary.start) + nBoundary.start
-}
-
-// randomSliceAndMapSize returns a random integer between [0,randomSliceAndMapSize). If the testRandZero is set, returns 0
-// Written for test purposes for shouldSetNil
-func randomSliceAndMapSize() int {
-	if testRandZero {
-		return 0
-	}
-	if isFixedSize {
-		return randomSize
-	}
-	return rand.Intn(randomSize)
-}
-
-func randomElementFromSliceString(s []string) string {
-	return s[rand.Int()%len(s)]
-}
-func randomStringNumber(n int) string {
-	b := make([]byte, n)
-	for i, cache, remain := n-1, rand.Int63(), letterIdxMax; i >= 0; {
-		if remain == 0 {
-			cache, remain = rand.Int63(), letterIdxMax
-		}
-		if idx := int(cache & letterIdxMask); idx < len(numberBytes) {
-			b[i] = numberBytes[idx]
-			i--
-		}
-		cache >>= letterIdxBits
-		remain--
-	}
-
-	return string(b)
-}
-
 // RandomInt Get three parameters , only first mandatory and the rest are optional
 // 		If only set one parameter :  This means the minimum number of digits and the total number
 // 		If only set two parameters : First this is min digit and second max digit and the total number the difference between them
 // 		If only three parameters: the third argument set Max count Digit
 func RandomInt(parameters ...int) (p []int, err error) {
-	switch len(parameters) {
-	case 1:
-		minCount := parameters[0]
-		p = rand.Perm(minCount)
-		for i := range p {
-			p[i] += minCount
-		}
-	case 2:
-		minDigit, maxDigit := parameters[0], parameters[1]
-		p = rand.Perm(maxDigit - minDigit + 1)
-
-		for i := range p {
-			p[i] += minDigit
-		}
-	default:
-		err = fmt.Errorf(ErrMoreArguments, len(parameters))
-	}
-	return p, err
-}
-
-func generateUnique(dataType string, fn func() interface{}) (interface{}, error) {
-	for i := 0; i < maxRetry; i++ {
-		value := fn()
-		if !slice.ContainsValue(uniqueValues[dataType], value) { // Retry if unique value already found
-			uniqueValues[dataType] = append(uniqueValues[dataType], value)
-			return value, nil
-		}
-	}
-	return reflect.Value{}, fmt.Errorf(ErrUniqueFailure, dataType)
+	return randomInt(globalRand, parameters...)
 }
 
 func singleFakeData(dataType string, fn func() interface{}) interface{} {
-	if generateUniqueValues {
-		v, err := generateUnique(dataType, fn)
+	settingsMu.RLock()
+	unique := generateUniqueValues
+	settingsMu.RUnlock()
+
+	if unique {
+		v, err := Unique(dataType, fn)
 		if err != nil {
 			panic(err)
 		}
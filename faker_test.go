@@ -1,10 +1,12 @@
 package faker
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -564,7 +566,7 @@ func validateRange(value int) error {
 
 func TestSetDataWithTagIfFirstArgumentNotPtr(t *testing.T) {
 	temp := struct{}{}
-	if setDataWithTag(reflect.ValueOf(temp), "").Error() != "Not a pointer value" {
+	if setDataWithTag(reflect.ValueOf(temp), "", newOptions()).Error() != "Not a pointer value" {
 		t.Error("Expected in arguments not ptr")
 	}
 }
@@ -961,24 +963,25 @@ func TestUnique(t *testing.T) {
 		IntVal    int    `faker:"unique"`
 	}
 
+	found := []string{}
 	for i := 0; i < 50; i++ {
 		val := UniqueStruct{}
 		err := FakeData(&val)
 		if err != nil {
 			t.Fatal("can't fake the unique data", err)
 		}
-	}
-
-	found := []interface{}{}
-	for _, v := range uniqueValues["word"] {
 		for _, f := range found {
-			if f == v {
-				t.Errorf("expected unique values, found \"%s\" at least twice", v)
+			if f == val.StringVal {
+				t.Errorf("expected unique values, found \"%s\" at least twice", f)
 				ResetUnique()
 				return
 			}
 		}
-		found = append(found, v)
+		found = append(found, val.StringVal)
+	}
+
+	if length := uniqueValues["github.com/togglhire/faker/v3.UniqueStruct.StringVal"].len(); length != 50 {
+		t.Errorf("expected 50 unique values, but got %d", length)
 	}
 
 	ResetUnique()
@@ -1244,3 +1247,216 @@ func TestUseTag_Ptr(t *testing.T) {
 		return
 	}
 }
+
+type HookedItem struct {
+	Price    int `faker:"boundary_start=1, boundary_end=100"`
+	Quantity int `faker:"boundary_start=1, boundary_end=10"`
+	Subtotal int
+}
+
+func (i *HookedItem) AfterFake() error {
+	i.Subtotal = i.Price * i.Quantity
+	return nil
+}
+
+type HookedOrder struct {
+	Items []HookedItem
+	Total int
+}
+
+func (o *HookedOrder) AfterFake() error {
+	o.Total = 0
+	for _, item := range o.Items {
+		o.Total += item.Subtotal
+	}
+	return nil
+}
+
+type FailingHook struct {
+	Name string
+}
+
+func (f FailingHook) AfterFake() error {
+	return fmt.Errorf("can't fake %s", f.Name)
+}
+
+func TestAfterFake(t *testing.T) {
+	order := HookedOrder{}
+	if err := FakeData(&order); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	total := 0
+	for _, item := range order.Items {
+		if item.Subtotal != item.Price*item.Quantity {
+			t.Errorf("expected the hook of the slice element to set the subtotal, got %+v", item)
+		}
+		total += item.Price * item.Quantity
+	}
+	if order.Total != total {
+		t.Errorf("expected total %d, but got %d", total, order.Total)
+	}
+}
+
+func TestAfterFakeError(t *testing.T) {
+	nested := struct {
+		Hook *FailingHook
+	}{}
+	if err := FakeData(&nested); err == nil {
+		t.Error("Expected error from AfterFake, but got nil")
+	}
+}
+
+func TestUniqueScopes(t *testing.T) {
+	type User struct {
+		Email string `faker:"email,unique"`
+		Login string `faker:"username,unique=logins"`
+	}
+	type Admin struct {
+		Email string `faker:"email,unique"`
+		Login string `faker:"username,unique=logins"`
+	}
+	defer ResetUnique()
+
+	for i := 0; i < 5; i++ {
+		if err := FakeData(&User{}); err != nil {
+			t.Fatal("can't fake the unique data", err)
+		}
+		if err := FakeData(&Admin{}); err != nil {
+			t.Fatal("can't fake the unique data", err)
+		}
+	}
+
+	if uniqueValues["github.com/togglhire/faker/v3.User.Email"].len() != 5 || uniqueValues["github.com/togglhire/faker/v3.Admin.Email"].len() != 5 {
+		t.Errorf("expected a unique scope per struct field, got %v", uniqueValues)
+	}
+	if uniqueValues["logins"].len() != 10 {
+		t.Errorf("expected the named scope to be shared, got %v", uniqueValues["logins"])
+	}
+
+	ResetUnique("logins")
+	if _, ok := uniqueValues["logins"]; ok {
+		t.Error("expected the named scope to be reset")
+	}
+	if uniqueValues["github.com/togglhire/faker/v3.User.Email"].len() != 5 {
+		t.Error("expected the other scopes to be kept")
+	}
+}
+
+func TestConcurrentUse(t *testing.T) {
+	type Sample struct {
+		Email string `faker:"email,unique"`
+		Card  string `faker:"cc_number"`
+		Tags  []string
+	}
+	defer ResetUnique()
+
+	run := time.Now().UnixNano()
+	var wg sync.WaitGroup
+	errs := make(chan error, 40)
+	for i := 0; i < 10; i++ {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			errs <- FakeData(&Sample{})
+		}()
+		go func(i int) {
+			defer wg.Done()
+			errs <- AddProvider(fmt.Sprintf("concurrent-%d-%d", run, i), func(v reflect.Value) (interface{}, error) {
+				return "concurrent", nil
+			})
+		}(i)
+		go func() {
+			defer wg.Done()
+			SetGenerateUniqueValues(false)
+			SetNilIfLenIsZero(false)
+			SetPayment(GetPayment())
+			errs <- SetRandomStringLength(25)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = CCNumber()
+			_ = Word()
+			ResetUnique("github.com/togglhire/faker/v3.Sample.Email")
+			errs <- nil
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+type tenantKey struct{}
+
+func TestFakeDataContext(t *testing.T) {
+	type Account struct {
+		Tenant string `faker:"tenant"`
+		Email  string `faker:"email"`
+	}
+	err := AddContextProvider("tenant", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		tenant, _ := ctx.Value(tenantKey{}).(string)
+		return tenant, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := AddContextProvider("tenant", nil); err == nil || err.Error() != ErrTagAlreadyExists {
+		t.Errorf("expected tag exists error, but got %v", err)
+	}
+	if err := AddProvider("tenant", nil); err == nil || err.Error() != ErrTagAlreadyExists {
+		t.Errorf("expected tag exists error, but got %v", err)
+	}
+
+	var a Account
+	if err := FakeDataContext(context.WithValue(context.Background(), tenantKey{}, "acme"), &a); err != nil {
+		t.Fatal(err)
+	}
+	if a.Tenant != "acme" || a.Email == "" {
+		t.Errorf("expected the tenant of the context, but got %+v", a)
+	}
+	if err := FakeData(&a); err != nil {
+		t.Fatal(err)
+	}
+	if a.Tenant != "" {
+		t.Errorf("expected no tenant without context, but got %+v", a)
+	}
+}
+
+func TestFakeDataContextCancel(t *testing.T) {
+	type Item struct {
+		Value string `faker:"cancelling"`
+	}
+	type Order struct {
+		Items []Item
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := AddProvider("cancelling", func(v reflect.Value) (interface{}, error) {
+		calls++
+		if calls == 5 {
+			cancel()
+		}
+		return "item", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SetFixedMapAndSliceSize(50); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = SetRandomMapAndSliceSize(100) }()
+
+	var o Order
+	if err := FakeDataContext(ctx, &o); err != context.Canceled {
+		t.Errorf("expected context canceled, but got %v", err)
+	}
+	if calls != 5 {
+		t.Errorf("expected the generation to stop after 5 items, but got %d", calls)
+	}
+	if err := FakeDataContext(ctx, &o); err != context.Canceled {
+		t.Errorf("expected context canceled, but got %v", err)
+	}
+}
@@ -0,0 +1,243 @@
+package faker
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// earthRadiusKm is the mean radius of the Earth
+const earthRadiusKm = 6371.0
+
+// LatLng is a pair of coordinates, in degrees
+type LatLng struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+}
+
+// GeoJSONPoint is a GeoJSON Point geometry, its coordinates are a longitude and a latitude
+type GeoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// GeoJSONLineString is a GeoJSON LineString geometry
+type GeoJSONLineString struct {
+	Type        string       `json:"type"`
+	Coordinates [][2]float64 `json:"coordinates"`
+}
+
+// GeoJSONPolygon is a GeoJSON Polygon geometry made of a single closed and counterclockwise ring
+type GeoJSONPolygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+// geoArea is the area the coordinates are generated in: a circle when radius is set, a bounding box otherwise
+type geoArea struct {
+	center LatLng
+	radius float64
+	// bbox is the min latitude, min longitude, max latitude and max longitude, the longitudes can cross the antimeridian
+	bbox [4]float64
+}
+
+// localeArea returns the bounding box of the locale of the generation, the whole globe when it has none
+func (i Address) localeArea() geoArea {
+	if b := i.locale().bbox; b != nil {
+		return geoArea{bbox: [4]float64{float64(b[0]), float64(b[1]), float64(b[2]), float64(b[3])}}
+	}
+	return geoArea{bbox: [4]float64{-90, -180, 90, 180}}
+}
+
+// area returns the area set with the near and radius_km or bbox tag parameters, the one of the locale otherwise
+func (i Address) area() (geoArea, error) {
+	near, hasNear := i.param(Near)
+	radius, hasRadius := i.param(RadiusKm)
+	bbox, hasBBox := i.param(BBox)
+	switch {
+	case hasBBox && !hasNear && !hasRadius:
+		values, ok := parseCoordinates(bbox, 4)
+		if !ok || values[0] > values[2] || !validLatLng(values[0], values[1]) || !validLatLng(values[2], values[3]) {
+			return geoArea{}, fmt.Errorf(ErrWrongFormattedTag, BBox+Equals+bbox)
+		}
+		return geoArea{bbox: [4]float64{values[0], values[1], values[2], values[3]}}, nil
+	case hasNear && hasRadius && !hasBBox:
+		values, ok := parseCoordinates(near, 2)
+		if !ok || !validLatLng(values[0], values[1]) {
+			return geoArea{}, fmt.Errorf(ErrWrongFormattedTag, Near+Equals+near)
+		}
+		km, err := strconv.ParseFloat(radius, 64)
+		if err != nil || km <= 0 {
+			return geoArea{}, fmt.Errorf(ErrWrongFormattedTag, RadiusKm+Equals+radius)
+		}
+		return geoArea{center: LatLng{values[0], values[1]}, radius: km}, nil
+	case hasNear || hasRadius || hasBBox:
+		return geoArea{}, fmt.Errorf(ErrWrongFormattedTag, "near and radius_km, or bbox")
+	}
+	return i.localeArea(), nil
+}
+
+// parseCoordinates parses n numbers separated by colons
+func parseCoordinates(s string, n int) ([]float64, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) != n {
+		return nil, false
+	}
+	values := make([]float64, n)
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, false
+		}
+		values[i] = value
+	}
+	return values, true
+}
+
+func validLatLng(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// point returns random coordinates in the area, uniformly distributed on the surface of the Earth within circles
+func (a geoArea) point(r *rand.Rand) LatLng {
+	if a.radius > 0 {
+		return destination(a.center, 360*r.Float64(), a.radius*math.Sqrt(r.Float64()))
+	}
+	width := a.bbox[3] - a.bbox[1]
+	if width < 0 {
+		width += 360
+	}
+	return LatLng{
+		Latitude:  a.bbox[0] + r.Float64()*(a.bbox[2]-a.bbox[0]),
+		Longitude: normalizeLongitude(a.bbox[1] + r.Float64()*width),
+	}
+}
+
+// shape returns the center and radius, in km, of a random shape fitting in the area
+func (a geoArea) shape(r *rand.Rand) (LatLng, float64) {
+	if a.radius > 0 {
+		return destination(a.center, 360*r.Float64(), a.radius/2*math.Sqrt(r.Float64())), a.radius / 2
+	}
+	inner := a.bbox
+	height, width := inner[2]-inner[0], inner[3]-inner[1]
+	if width < 0 {
+		width += 360
+	}
+	inner[0], inner[2] = inner[0]+height/4, inner[2]-height/4
+	inner[1], inner[3] = normalizeLongitude(inner[1]+width/4), normalizeLongitude(inner[3]-width/4)
+	center := geoArea{bbox: inner}.point(r)
+	radius := math.Min(height/4, width/4*math.Cos(center.Latitude*math.Pi/180)) * math.Pi / 180 * earthRadiusKm
+	return center, math.Min(radius, 50)
+}
+
+// destination returns the point at distance km from start, in the direction of bearing, in degrees from the north
+func destination(start LatLng, bearing, km float64) LatLng {
+	lat1, lng1 := start.Latitude*math.Pi/180, start.Longitude*math.Pi/180
+	theta, delta := bearing*math.Pi/180, km/earthRadiusKm
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lng2 := lng1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2))
+	return LatLng{Latitude: lat2 * 180 / math.Pi, Longitude: normalizeLongitude(lng2 * 180 / math.Pi)}
+}
+
+func normalizeLongitude(lng float64) float64 {
+	for lng >= 180 {
+		lng -= 360
+	}
+	for lng < -180 {
+		lng += 360
+	}
+	return lng
+}
+
+// geoValue returns value, or its JSON encoding for string fields
+func geoValue(v reflect.Value, value interface{}) (interface{}, error) {
+	if v.Kind() == reflect.String {
+		b, err := json.Marshal(value)
+		return string(b), err
+	}
+	return value, nil
+}
+
+// LatLng returns a LatLng in the area set with the near and radius_km or bbox tag parameters, e.g.
+// `faker:"lat_lng,near=52.52:13.40,radius_km=5"` or `faker:"lat_lng,bbox=47.27:5.87:55.06:15.04"`
+func (i Address) LatLng(v reflect.Value) (interface{}, error) {
+	area, err := i.area()
+	if err != nil {
+		return nil, err
+	}
+	return area.point(i.rnd()), nil
+}
+
+// FakeLatLng get fake LatLng
+func FakeLatLng() LatLng {
+	return singleFakeData(LatLngTag, func() interface{} {
+		a := Address{}
+		return a.localeArea().point(a.rnd())
+	}).(LatLng)
+}
+
+func (i Address) geoJSONPoint(area geoArea) GeoJSONPoint {
+	p := area.point(i.rnd())
+	return GeoJSONPoint{Type: "Point", Coordinates: [2]float64{p.Longitude, p.Latitude}}
+}
+
+// GeoJSONPoint returns a GeoJSONPoint in the area of the tag parameters, see LatLng.
+// String fields get its JSON encoding.
+func (i Address) GeoJSONPoint(v reflect.Value) (interface{}, error) {
+	area, err := i.area()
+	if err != nil {
+		return nil, err
+	}
+	return geoValue(v, i.geoJSONPoint(area))
+}
+
+func (i Address) geoJSONLineString(area geoArea) GeoJSONLineString {
+	center, radius := area.shape(i.rnd())
+	n := 2 + i.rnd().Intn(7)
+	line := GeoJSONLineString{Type: "LineString", Coordinates: make([][2]float64, n)}
+	for k := range line.Coordinates {
+		p := destination(center, 360*i.rnd().Float64(), radius*math.Sqrt(i.rnd().Float64()))
+		line.Coordinates[k] = [2]float64{p.Longitude, p.Latitude}
+	}
+	return line
+}
+
+// GeoJSONLineString returns a GeoJSONLineString of 2 to 8 points in the area of the tag parameters, see LatLng.
+// String fields get its JSON encoding.
+func (i Address) GeoJSONLineString(v reflect.Value) (interface{}, error) {
+	area, err := i.area()
+	if err != nil {
+		return nil, err
+	}
+	return geoValue(v, i.geoJSONLineString(area))
+}
+
+func (i Address) geoJSONPolygon(area geoArea) GeoJSONPolygon {
+	center, radius := area.shape(i.rnd())
+	n := 3 + i.rnd().Intn(6)
+	start := 360 * i.rnd().Float64()
+	ring := make([][2]float64, n+1)
+	for k := range ring[:n] {
+		// The bearings grow clockwise, going through them backwards gives the counterclockwise ring of RFC 7946.
+		// They are less than 180 degrees apart so that the center is inside the ring.
+		bearing := start - (float64(k)+0.4*i.rnd().Float64())*360/float64(n)
+		p := destination(center, bearing, radius*(0.5+i.rnd().Float64()/2))
+		ring[k] = [2]float64{p.Longitude, p.Latitude}
+	}
+	ring[n] = ring[0]
+	return GeoJSONPolygon{Type: "Polygon", Coordinates: [][][2]float64{ring}}
+}
+
+// GeoJSONPolygon returns a GeoJSONPolygon of 3 to 8 vertices in the area of the tag parameters, see LatLng.
+// String fields get its JSON encoding.
+func (i Address) GeoJSONPolygon(v reflect.Value) (interface{}, error) {
+	area, err := i.area()
+	if err != nil {
+		return nil, err
+	}
+	return geoValue(v, i.geoJSONPolygon(area))
+}
@@ -0,0 +1,129 @@
+package faker
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// distanceKm returns the great-circle distance between a and b
+func distanceKm(a, b LatLng) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat, dLng := lat2-lat1, (b.Longitude-a.Longitude)*math.Pi/180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+type GeoRecord struct {
+	Latitude  float64           `faker:"lat,near=52.52:13.40,radius_km=5"`
+	Longitude float32           `faker:"long,bbox=47.27:5.87:55.06:15.04"`
+	Point     LatLng            `faker:"lat_lng,near=52.52:13.40,radius_km=5"`
+	Pacific   *LatLng           `faker:"lat_lng,bbox=-20:170:20:-170"`
+	GeoPoint  GeoJSONPoint      `faker:"geojson_point,near=48.85:2.35,radius_km=10"`
+	Line      GeoJSONLineString `faker:"geojson_linestring,near=48.85:2.35,radius_km=10"`
+	Polygon   *GeoJSONPolygon   `faker:"geojson_polygon,bbox=47.27:5.87:55.06:15.04"`
+	Text      string            `faker:"geojson_polygon,near=48.85:2.35,radius_km=10"`
+	Globe     GeoJSONPolygon    `faker:"geojson_polygon"`
+}
+
+func TestGeo(t *testing.T) {
+	berlin, paris := LatLng{52.52, 13.40}, LatLng{48.85, 2.35}
+	for i := 0; i < 100; i++ {
+		var r GeoRecord
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(r.Latitude-berlin.Latitude) > 5/111.0 {
+			t.Errorf("expected a latitude within 5km of Berlin, but got %f", r.Latitude)
+		}
+		if r.Longitude < 5.87 || r.Longitude > 15.04 {
+			t.Errorf("expected a longitude in the bounding box, but got %f", r.Longitude)
+		}
+		if d := distanceKm(berlin, r.Point); d > 5 {
+			t.Errorf("expected a point within 5km of Berlin, but got %+v at %fkm", r.Point, d)
+		}
+		if r.Pacific == nil || math.Abs(r.Pacific.Latitude) > 20 || math.Abs(r.Pacific.Longitude) < 170 {
+			t.Errorf("expected a point across the antimeridian, but got %+v", r.Pacific)
+		}
+		if c := r.GeoPoint.Coordinates; r.GeoPoint.Type != "Point" || distanceKm(paris, LatLng{c[1], c[0]}) > 10 {
+			t.Errorf("expected a point within 10km of Paris, but got %+v", r.GeoPoint)
+		}
+		if n := len(r.Line.Coordinates); r.Line.Type != "LineString" || n < 2 || n > 8 {
+			t.Errorf("expected a line of 2 to 8 points, but got %+v", r.Line)
+		}
+		for _, c := range r.Line.Coordinates {
+			if d := distanceKm(paris, LatLng{c[1], c[0]}); d > 10 {
+				t.Errorf("expected a line within 10km of Paris, but got a point at %fkm", d)
+			}
+		}
+		checkPolygon(t, r.Polygon, 47.27, 5.87, 55.06, 15.04)
+		checkPolygon(t, &r.Globe, -90, -180, 90, 180)
+		var text GeoJSONPolygon
+		if err := json.Unmarshal([]byte(r.Text), &text); err != nil {
+			t.Fatal(err)
+		}
+		checkPolygon(t, &text, 48.75, 2.2, 48.95, 2.5)
+	}
+}
+
+// checkPolygon checks that p is a closed counterclockwise ring in the bounding box
+func checkPolygon(t *testing.T, p *GeoJSONPolygon, minLat, minLng, maxLat, maxLng float64) {
+	t.Helper()
+	if p == nil || p.Type != "Polygon" || len(p.Coordinates) != 1 {
+		t.Fatalf("expected a polygon with a single ring, but got %+v", p)
+	}
+	ring := p.Coordinates[0]
+	if len(ring) < 4 || len(ring) > 9 || ring[0] != ring[len(ring)-1] {
+		t.Fatalf("expected a closed ring of 3 to 8 vertices, but got %v", ring)
+	}
+	area := 0.0
+	for i := 0; i < len(ring)-1; i++ {
+		area += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+		if ring[i][1] < minLat || ring[i][1] > maxLat || ring[i][0] < minLng || ring[i][0] > maxLng {
+			t.Errorf("expected the vertices in the bounding box, but got %v", ring[i])
+		}
+	}
+	if area <= 0 {
+		t.Errorf("expected a counterclockwise ring, but got %v", ring)
+	}
+}
+
+func TestGeoWithLocale(t *testing.T) {
+	var r struct {
+		Point LatLng `faker:"lat_lng"`
+	}
+	if err := FakeData(&r, WithLocale("ja_JP")); err != nil {
+		t.Fatal(err)
+	}
+	if b := locales["ja_JP"].bbox; r.Point.Latitude < float64(b[0]) || r.Point.Latitude > float64(b[2]) {
+		t.Errorf("expected a point in Japan, but got %+v", r.Point)
+	}
+	if p := FakeLatLng(); !validLatLng(p.Latitude, p.Longitude) {
+		t.Errorf("expected valid coordinates, but got %+v", p)
+	}
+}
+
+func TestGeoWrongTag(t *testing.T) {
+	for _, params := range []map[string]string{
+		{Near: "52.52:13.40"},
+		{RadiusKm: "5"},
+		{Near: "52.52", RadiusKm: "5"},
+		{Near: "95:13.40", RadiusKm: "5"},
+		{Near: "52.52:13.40", RadiusKm: "-1"},
+		{BBox: "55:5:47:15"},
+		{BBox: "1:2:3"},
+		{BBox: "47:5:55:15", RadiusKm: "5"},
+	} {
+		if _, err := (Address{provider{params: params}}).Latitude(reflect.ValueOf(0.0)); err == nil {
+			t.Errorf("%v: expected an error", params)
+		}
+	}
+	var r struct {
+		Latitude float64 `faker:"lat,near=52.52:13.40"`
+	}
+	if err := FakeData(&r); err == nil || err.Error() != fmt.Sprintf(ErrWrongFormattedTag, "near and radius_km, or bbox") {
+		t.Errorf("expected a wrong formatted tag error, but got %v", err)
+	}
+}
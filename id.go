@@ -0,0 +1,237 @@
+package faker
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// crockfordAlphabet is the base 32 alphabet of the ULIDs
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// nanoIDAlphabet is the URL safe alphabet of the NanoIDs
+	nanoIDAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+	// nanoIDSize is the default length of the NanoIDs
+	nanoIDSize = 21
+	// ksuidEpoch is the Unix time the timestamps of the KSUIDs count from
+	ksuidEpoch = 1400000000
+	// snowflakeEpoch is the Unix time in milliseconds the timestamps of the Snowflake IDs count from, the one of Twitter
+	snowflakeEpoch = 1288834974657
+)
+
+// ulid returns a ULID: a Unix timestamp in milliseconds followed by 80 random bits
+func (u UUID) ulid() ([]byte, error) {
+	b, err := u.random(16)
+	if err != nil {
+		return nil, err
+	}
+	ms := uint64(u.now(time.Millisecond).UnixNano() / int64(time.Millisecond))
+	binary.BigEndian.PutUint16(b[0:], uint16(ms>>32))
+	binary.BigEndian.PutUint32(b[2:], uint32(ms))
+	return b, nil
+}
+
+// encodeULID writes the 128 bits of a ULID in 26 characters of Crockford's base 32
+func encodeULID(b []byte) string {
+	text := new(big.Int).SetBytes(b).Text(32)
+	encoded := make([]byte, 26)
+	for k := range encoded {
+		encoded[k] = '0'
+	}
+	for k := range text {
+		c := text[len(text)-1-k]
+		digit := c - '0'
+		if c >= 'a' {
+			digit = c - 'a' + 10
+		}
+		encoded[len(encoded)-1-k] = crockfordAlphabet[digit]
+	}
+	return string(encoded)
+}
+
+// ULID returns a 26 characters ULID, or its bytes to [16]byte fields
+func (u UUID) ULID(v reflect.Value) (interface{}, error) {
+	b, err := u.ulid()
+	if err != nil {
+		return nil, err
+	}
+	return idValue(v, b, encodeULID(b))
+}
+
+// ULID get fake ULID
+func ULID() string {
+	return singleFakeData(ULIDTag, func() interface{} {
+		u := UUID{}
+		b, err := u.ulid()
+		if err != nil {
+			return ""
+		}
+		return encodeULID(b)
+	}).(string)
+}
+
+// ksuid returns a KSUID: a timestamp in seconds since the KSUID epoch followed by 128 random bits
+func (u UUID) ksuid() ([]byte, error) {
+	b, err := u.random(20)
+	if err != nil {
+		return nil, err
+	}
+	binary.BigEndian.PutUint32(b, uint32(u.now(time.Second).Unix()-ksuidEpoch))
+	return b, nil
+}
+
+// encodeKSUID writes the 160 bits of a KSUID in 27 characters of base 62, whose digits are followed by the upper case
+// letters and then the lower case ones
+func encodeKSUID(b []byte) string {
+	text := new(big.Int).SetBytes(b).Text(62)
+	swapped := strings.Map(func(c rune) rune {
+		switch {
+		case c >= 'a' && c <= 'z':
+			return c - 'a' + 'A'
+		case c >= 'A' && c <= 'Z':
+			return c - 'A' + 'a'
+		}
+		return c
+	}, text)
+	return strings.Repeat("0", 27-len(swapped)) + swapped
+}
+
+// KSUID returns a 27 characters KSUID, or its bytes to [20]byte fields
+func (u UUID) KSUID(v reflect.Value) (interface{}, error) {
+	b, err := u.ksuid()
+	if err != nil {
+		return nil, err
+	}
+	return idValue(v, b, encodeKSUID(b))
+}
+
+// KSUID get fake KSUID
+func KSUID() string {
+	return singleFakeData(KSUIDTag, func() interface{} {
+		u := UUID{}
+		b, err := u.ksuid()
+		if err != nil {
+			return ""
+		}
+		return encodeKSUID(b)
+	}).(string)
+}
+
+// objectID returns a MongoDB ObjectID: a Unix timestamp in seconds followed by a random process value and a random counter
+func (u UUID) objectID() ([]byte, error) {
+	b, err := u.random(12)
+	if err != nil {
+		return nil, err
+	}
+	binary.BigEndian.PutUint32(b, uint32(u.now(time.Second).Unix()))
+	return b, nil
+}
+
+// ObjectID returns a 24 characters hexadecimal MongoDB ObjectID, or its bytes to [12]byte fields
+func (u UUID) ObjectID(v reflect.Value) (interface{}, error) {
+	b, err := u.objectID()
+	if err != nil {
+		return nil, err
+	}
+	return idValue(v, b, hex.EncodeToString(b))
+}
+
+// ObjectID get fake MongoDB ObjectID
+func ObjectID() string {
+	return singleFakeData(ObjectIDTag, func() interface{} {
+		u := UUID{}
+		b, err := u.objectID()
+		if err != nil {
+			return ""
+		}
+		return hex.EncodeToString(b)
+	}).(string)
+}
+
+// snowflake returns a Snowflake ID: 41 bits of milliseconds since the Twitter epoch followed by a random 10 bits
+// machine ID and a random 12 bits sequence number
+func (u UUID) snowflake() (int64, error) {
+	b, err := u.random(4)
+	if err != nil {
+		return 0, err
+	}
+	ms := u.now(time.Millisecond).UnixNano()/int64(time.Millisecond) - snowflakeEpoch
+	return ms<<22 | int64(binary.BigEndian.Uint32(b)&(1<<22-1)), nil
+}
+
+// Snowflake returns a Snowflake ID to integer fields, or its decimal string to string fields
+func (u UUID) Snowflake(v reflect.Value) (interface{}, error) {
+	id, err := u.snowflake()
+	if err != nil {
+		return nil, err
+	}
+	switch v.Kind() {
+	case reflect.Invalid, reflect.String:
+		return strconv.FormatInt(id, 10), nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return reflect.ValueOf(id).Convert(v.Type()).Interface(), nil
+	}
+	return nil, errors.New(ErrNotSupportedTypeForTag)
+}
+
+// Snowflake get fake Snowflake ID
+func Snowflake() int64 {
+	return singleFakeData(SnowflakeTag, func() interface{} {
+		u := UUID{}
+		id, _ := u.snowflake()
+		return id
+	}).(int64)
+}
+
+// nanoIDLength returns the length set with the size tag parameter, 21 by default
+func (u UUID) nanoIDLength() (int, error) {
+	value, ok := u.param(SizeParam)
+	if !ok {
+		return nanoIDSize, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf(ErrWrongFormattedTag, SizeParam+Equals+value)
+	}
+	return n, nil
+}
+
+// nanoID returns a NanoID of size characters
+func (u UUID) nanoID(size int) (string, error) {
+	b, err := u.random(size)
+	if err != nil {
+		return "", err
+	}
+	for k := range b {
+		// the alphabet has 64 characters, which keeps them evenly distributed
+		b[k] = nanoIDAlphabet[b[k]&63]
+	}
+	return string(b), nil
+}
+
+// NanoID returns a URL safe NanoID, e.g. `faker:"nanoid,size=10"`
+func (u UUID) NanoID(v reflect.Value) (interface{}, error) {
+	size, err := u.nanoIDLength()
+	if err != nil {
+		return nil, err
+	}
+	return u.nanoID(size)
+}
+
+// NanoID get fake NanoID
+func NanoID() string {
+	return singleFakeData(NanoIDTag, func() interface{} {
+		u := UUID{}
+		id, err := u.nanoID(nanoIDSize)
+		if err != nil {
+			return ""
+		}
+		return id
+	}).(string)
+}
@@ -0,0 +1,140 @@
+package faker
+
+import (
+	"encoding/binary"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestULID(t *testing.T) {
+	if s := encodeULID(make([]byte, 16)); s != "00000000000000000000000000" {
+		t.Errorf("expected the zero ULID, but got %s", s)
+	}
+	max := []byte(strings.Repeat("\xff", 16))
+	if s := encodeULID(max); s != "7ZZZZZZZZZZZZZZZZZZZZZZZZZ" {
+		t.Errorf("expected the max ULID, but got %s", s)
+	}
+
+	u := UUID{}
+	res, err := u.ULID(reflect.Value{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile("^[0-7][0-9A-HJKMNP-TV-Z]{25}$").MatchString(res.(string)) {
+		t.Errorf("expected a ULID, but got %s", res)
+	}
+	b, err := u.ulid()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms := int64(binary.BigEndian.Uint64(b) >> 16)
+	if d := time.Since(time.Unix(0, ms*int64(time.Millisecond))); d < -time.Second || d > time.Second {
+		t.Errorf("expected the timestamp of now, but got %d", ms)
+	}
+	if s := ULID(); len(s) != 26 {
+		t.Errorf("expected a ULID, but got %s", s)
+	}
+}
+
+func TestKSUID(t *testing.T) {
+	if s := encodeKSUID(make([]byte, 20)); s != "000000000000000000000000000" {
+		t.Errorf("expected the zero KSUID, but got %s", s)
+	}
+	if s := encodeKSUID([]byte(strings.Repeat("\xff", 20))); s != "aWgEPTl1tmebfsQzFP4bxwgy80V" {
+		t.Errorf("expected the max KSUID, but got %s", s)
+	}
+
+	var r struct {
+		String string   `faker:"ksuid"`
+		Bytes  [20]byte `faker:"ksuid"`
+	}
+	if err := FakeData(&r); err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile("^[0-9A-Za-z]{27}$").MatchString(r.String) {
+		t.Errorf("expected a KSUID, but got %s", r.String)
+	}
+	if ts := int64(binary.BigEndian.Uint32(r.Bytes[:])) + ksuidEpoch; time.Now().Unix()-ts > 1 {
+		t.Errorf("expected the timestamp of now, but got %d", ts)
+	}
+	if s := KSUID(); len(s) != 27 {
+		t.Errorf("expected a KSUID, but got %s", s)
+	}
+}
+
+func TestObjectID(t *testing.T) {
+	var r struct {
+		String string   `faker:"object_id"`
+		Bytes  [12]byte `faker:"object_id"`
+	}
+	if err := FakeData(&r); err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile("^[0-9a-f]{24}$").MatchString(r.String) {
+		t.Errorf("expected an ObjectID, but got %s", r.String)
+	}
+	if ts := int64(binary.BigEndian.Uint32(r.Bytes[:])); time.Now().Unix()-ts > 1 {
+		t.Errorf("expected the timestamp of now, but got %d", ts)
+	}
+	if s := ObjectID(); len(s) != 24 {
+		t.Errorf("expected an ObjectID, but got %s", s)
+	}
+}
+
+func TestSnowflake(t *testing.T) {
+	var r struct {
+		Int    int64  `faker:"snowflake"`
+		Uint   uint64 `faker:"snowflake"`
+		String string `faker:"snowflake"`
+	}
+	if err := FakeData(&r); err != nil {
+		t.Fatal(err)
+	}
+	ms := time.Now().UnixNano()/int64(time.Millisecond) - snowflakeEpoch
+	for _, id := range []int64{r.Int, int64(r.Uint), Snowflake()} {
+		if d := ms - id>>22; d < 0 || d > 1000 {
+			t.Errorf("expected the timestamp of now, but got %d", id>>22)
+		}
+	}
+	if _, err := strconv.ParseInt(r.String, 10, 64); err != nil {
+		t.Errorf("expected a decimal Snowflake ID, but got %s", r.String)
+	}
+
+	var wrong struct {
+		ID int16 `faker:"snowflake"`
+	}
+	if err := FakeData(&wrong); err == nil {
+		t.Error("expected an error for a 16 bits field")
+	}
+}
+
+func TestNanoID(t *testing.T) {
+	var r struct {
+		Default string `faker:"nanoid"`
+		Short   string `faker:"nanoid,size=10"`
+	}
+	if err := FakeData(&r); err != nil {
+		t.Fatal(err)
+	}
+	pattern := regexp.MustCompile("^[A-Za-z0-9_-]+$")
+	if len(r.Default) != 21 || !pattern.MatchString(r.Default) {
+		t.Errorf("expected a NanoID of 21 characters, but got %s", r.Default)
+	}
+	if len(r.Short) != 10 || !pattern.MatchString(r.Short) {
+		t.Errorf("expected a NanoID of 10 characters, but got %s", r.Short)
+	}
+	if s := NanoID(); len(s) != 21 {
+		t.Errorf("expected a NanoID, but got %s", s)
+	}
+
+	var wrong struct {
+		ID string `faker:"nanoid,size=0"`
+	}
+	if err := FakeData(&wrong); err == nil {
+		t.Error("expected an error for a size of 0")
+	}
+}
@@ -2,7 +2,6 @@ package faker
 
 import (
 	"fmt"
-	"math/rand"
 	"net"
 	"reflect"
 	"strings"
@@ -38,6 +37,9 @@ func GetNetworker() Networker {
 
 // SetNetwork sets custom Network
 func SetNetwork(net Networker) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	internet = net
 }
 
@@ -54,10 +56,12 @@ type Networker interface {
 }
 
 // Internet struct
-type Internet struct{}
+type Internet struct {
+	provider
+}
 
 func (internet Internet) email() string {
-	return randomString(7) + "@" + randomString(5) + "." + randomElementFromSliceString(tld)
+	return randomString(internet.rnd(), 7) + "@" + randomString(internet.rnd(), 5) + "." + randomElementFromSliceString(internet.rnd(), internet.dataset(tldsDataset))
 }
 
 // Email generates random email id
@@ -76,7 +80,7 @@ func Email() string {
 func (internet Internet) macAddress() string {
 	ip := make([]byte, 6)
 	for i := 0; i < 6; i++ {
-		ip[i] = byte(rand.Intn(256))
+		ip[i] = byte(internet.rnd().Intn(256))
 	}
 	return net.HardwareAddr(ip).String()
 }
@@ -95,7 +99,7 @@ func MacAddress() string {
 }
 
 func (internet Internet) domainName() string {
-	return randomString(7) + "." + randomElementFromSliceString(tld)
+	return randomString(internet.rnd(), 7) + "." + randomElementFromSliceString(internet.rnd(), internet.dataset(tldsDataset))
 }
 
 // DomainName generates random domain name
@@ -112,7 +116,7 @@ func DomainName() string {
 }
 
 func (internet Internet) url() string {
-	format := randomElementFromSliceString(urlFormats)
+	format := randomElementFromSliceString(internet.rnd(), urlFormats)
 	countVerbs := strings.Count(format, "%s")
 	if countVerbs == 1 {
 		return fmt.Sprintf(format, internet.domainName())
@@ -134,7 +138,7 @@ func URL() string {
 }
 
 func (internet Internet) username() string {
-	return randomString(7)
+	return randomString(internet.rnd(), 7)
 }
 
 // UserName generates random username
@@ -154,7 +158,7 @@ func (internet Internet) ipv4() string {
 	size := 4
 	ip := make([]byte, size)
 	for i := 0; i < size; i++ {
-		ip[i] = byte(rand.Intn(256))
+		ip[i] = byte(internet.rnd().Intn(256))
 	}
 	return net.IP(ip).To4().String()
 }
@@ -176,7 +180,7 @@ func (internet Internet) ipv6() string {
 	size := 16
 	ip := make([]byte, size)
 	for i := 0; i < size; i++ {
-		ip[i] = byte(rand.Intn(256))
+		ip[i] = byte(internet.rnd().Intn(256))
 	}
 	return net.IP(ip).To16().String()
 }
@@ -195,7 +199,7 @@ func IPv6() string {
 }
 
 func (internet Internet) password() string {
-	return randomString(50)
+	return randomString(internet.rnd(), 50)
 }
 
 // Password returns a hashed password
@@ -0,0 +1,185 @@
+package faker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is the locale used when none is set, it provides the datasets missing from the other locales
+const DefaultLocale = "en_US"
+
+// Names of the datasets the built-in providers draw from
+const (
+	titlesMaleDataset              = "titles_male"
+	titlesFemaleDataset            = "titles_female"
+	firstNamesDataset              = "first_names"
+	firstNamesMaleDataset          = "first_names_male"
+	firstNamesFemaleDataset        = "first_names_female"
+	lastNamesDataset               = "last_names"
+	wordsDataset                   = "words"
+	currenciesDataset              = "currencies"
+	tldsDataset                    = "tlds"
+	streetNamesDataset             = "street_names"
+	buildingNumberFormatsDataset   = "building_number_formats"
+	secondaryAddressFormatsDataset = "secondary_address_formats"
+	phoneFormatsDataset            = "phone_formats"
+	tollFreeFormatsDataset         = "toll_free_formats"
+	e164FormatsDataset             = "e164_formats"
+)
+
+// locale holds the datasets of a language and region
+type locale struct {
+	name     string
+	datasets map[string][]string
+	// familyNameFirst puts the last name before the first name in full names, without title
+	familyNameFirst bool
+	// bbox bounds the coordinates of the locale: min latitude, min longitude, max latitude, max longitude
+	bbox *[4]float32
+
+	// country, streetFormat, addressFormat and places make up the postal addresses of the locale, see RealAddress
+	country       country
+	streetFormat  string
+	addressFormat string
+	places        []place
+
+	moneyFormat moneyFormat
+}
+
+// moneyFormat is the way a locale writes amounts of money: the decimal and group separators of the amount,
+// and a pattern in which ¤ stands for the currency symbol and # for the amount
+type moneyFormat struct {
+	decimal string
+	group   string
+	pattern string
+}
+
+// bundledMoneyFormat is the money format of en_US, used by the locales without one
+var bundledMoneyFormat = moneyFormat{decimal: ".", group: ",", pattern: "¤#"}
+
+var (
+	localesMu = &sync.RWMutex{}
+	locales   = map[string]*locale{}
+	// localeAliases maps the languages to their bundled locale
+	localeAliases = map[string]string{}
+	// currentLocale is the locale set with SetLocale, guarded by settingsMu
+	currentLocale = DefaultLocale
+)
+
+func init() {
+	for _, l := range bundledLocales() {
+		if _, ok := l.datasets[firstNamesDataset]; !ok {
+			l.datasets[firstNamesDataset] = append(append([]string{}, l.datasets[firstNamesMaleDataset]...), l.datasets[firstNamesFemaleDataset]...)
+		}
+		locales[l.name] = l
+		language := strings.Split(l.name, "_")[0]
+		if _, ok := localeAliases[language]; !ok {
+			localeAliases[language] = l.name
+		}
+	}
+}
+
+// SetLocale sets the locale of the generated values, en_US by default.
+// A language, e.g. "de", stands for its bundled locale, see Locales.
+func SetLocale(name string) error {
+	l, err := findLocale(name)
+	if err != nil {
+		return err
+	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
+	currentLocale = l.name
+	return nil
+}
+
+// WithLocale generates the value in the given locale instead of the one set with SetLocale, e.g.
+//
+//	faker.FakeData(&u, faker.WithLocale("de_DE"))
+func WithLocale(name string) Option {
+	return func(o *options) {
+		o.locale = name
+	}
+}
+
+// Locales returns the names of the available locales
+func Locales() []string {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	names := make([]string, 0, len(locales))
+	for name := range locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// findLocale returns the locale called name, which can also be a language or use a dash, e.g. "pt-BR"
+func findLocale(name string) (*locale, error) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	name = strings.Replace(name, "-", "_", 1)
+	if l, ok := locales[name]; ok {
+		return l, nil
+	}
+	if alias, ok := localeAliases[strings.ToLower(name)]; ok {
+		return locales[alias], nil
+	}
+	return nil, fmt.Errorf(ErrUnknownLocale, name)
+}
+
+// locale returns the locale of the generation, the one set with SetLocale when it has none
+func (p provider) locale() *locale {
+	if p.loc != nil {
+		return p.loc
+	}
+	settingsMu.RLock()
+	name := currentLocale
+	settingsMu.RUnlock()
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	return locales[name]
+}
+
+// dataset returns the values of the dataset called name in the locale of the generation,
+// or the ones of the default locale when it lacks them
+func (p provider) dataset(name string) []string {
+	l := p.locale()
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	if values := l.datasets[name]; len(values) > 0 {
+		return values
+	}
+	return locales[DefaultLocale].datasets[name]
+}
+
+// localeDataset returns the values of the dataset called name in the locale of the generation, without fallback
+func (p provider) localeDataset(name string) []string {
+	l := p.locale()
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	return l.datasets[name]
+}
+
+// digits replaces each # of format with a random digit and each % with a random non zero digit
+func (p provider) digits(format string) string {
+	b := []byte(format)
+	for i := range b {
+		switch b[i] {
+		case '#':
+			b[i] = numberBytes[p.rnd().Intn(len(numberBytes))]
+		case '%':
+			b[i] = numberBytes[1+p.rnd().Intn(len(numberBytes)-1)]
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,312 @@
+package faker
+
+// bundledLocales returns the locales shipped with the package
+func bundledLocales() []*locale {
+	return []*locale{
+		{
+			name: DefaultLocale,
+			datasets: map[string][]string{
+				titlesMaleDataset:       titlesMale,
+				titlesFemaleDataset:     titlesFemale,
+				firstNamesDataset:       firstNames,
+				firstNamesMaleDataset:   firstNamesMale,
+				firstNamesFemaleDataset: firstNamesFemale,
+				lastNamesDataset:        lastNames,
+				wordsDataset:            wordList,
+				streetNamesDataset: {
+					"Main Street", "Oak Avenue", "Maple Drive", "Cedar Lane", "Elm Street",
+					"Pine Road", "Washington Boulevard", "Park Avenue", "Lake Drive", "Hill Road",
+					"Sunset Boulevard", "River Road", "Church Street", "Highland Avenue", "Franklin Street",
+					"Lincoln Avenue", "Jefferson Street", "Madison Avenue", "Spring Street", "Forest Lane",
+				},
+				buildingNumberFormatsDataset:   {"%#", "%##", "%###"},
+				secondaryAddressFormatsDataset: {"Apt. %#", "Apt. %##", "Suite %##", "Unit %"},
+				currenciesDataset:              currencies,
+				tldsDataset:                    tld,
+			},
+			country:       country{"United States", "US", "USA"},
+			streetFormat:  "{building_number} {street_name}",
+			addressFormat: "{street_address}\n{city}, {state_code} {postcode}\n{country}",
+			places: []place{
+				{"New York", "New York", "NY", "100##", 40.7128, -74.006},
+				{"Los Angeles", "California", "CA", "900##", 34.0522, -118.2437},
+				{"Chicago", "Illinois", "IL", "606##", 41.8781, -87.6298},
+				{"Houston", "Texas", "TX", "770##", 29.7604, -95.3698},
+				{"Phoenix", "Arizona", "AZ", "850##", 33.4484, -112.074},
+				{"Philadelphia", "Pennsylvania", "PA", "191##", 39.9526, -75.1652},
+				{"San Antonio", "Texas", "TX", "782##", 29.4241, -98.4936},
+				{"San Diego", "California", "CA", "921##", 32.7157, -117.1611},
+				{"Dallas", "Texas", "TX", "752##", 32.7767, -96.797},
+				{"Seattle", "Washington", "WA", "981##", 47.6062, -122.3321},
+				{"Denver", "Colorado", "CO", "802##", 39.7392, -104.9903},
+				{"Boston", "Massachusetts", "MA", "021##", 42.3601, -71.0589},
+				{"Atlanta", "Georgia", "GA", "303##", 33.749, -84.388},
+				{"Miami", "Florida", "FL", "331##", 25.7617, -80.1918},
+				{"Portland", "Oregon", "OR", "972##", 45.5152, -122.6784},
+				{"Nashville", "Tennessee", "TN", "372##", 36.1627, -86.7816},
+				{"Detroit", "Michigan", "MI", "482##", 42.3314, -83.0458},
+				{"Minneapolis", "Minnesota", "MN", "554##", 44.9778, -93.265},
+				{"Las Vegas", "Nevada", "NV", "891##", 36.1699, -115.1398},
+				{"Baltimore", "Maryland", "MD", "212##", 39.2904, -76.6122},
+			},
+			moneyFormat: bundledMoneyFormat,
+		},
+		{
+			name: "de_DE",
+			datasets: map[string][]string{
+				titlesMaleDataset:   {"Herr", "Dr.", "Prof.", "Prof. Dr."},
+				titlesFemaleDataset: {"Frau", "Dr.", "Prof.", "Prof. Dr."},
+				firstNamesMaleDataset: {
+					"Alexander", "Andreas", "Benjamin", "Christian", "Daniel", "David", "Elias", "Felix", "Finn", "Florian",
+					"Jan", "Jonas", "Julian", "Jürgen", "Klaus", "Leon", "Lukas", "Maximilian", "Michael", "Niklas",
+					"Paul", "Peter", "Sebastian", "Stefan", "Thomas", "Tobias", "Uwe", "Wolfgang",
+				},
+				firstNamesFemaleDataset: {
+					"Anna", "Andrea", "Birgit", "Claudia", "Emma", "Gabriele", "Hannah", "Ines", "Jana", "Julia",
+					"Katharina", "Laura", "Lea", "Lena", "Marie", "Mia", "Monika", "Petra", "Sabine", "Sandra",
+					"Sophie", "Stefanie", "Susanne", "Ursula",
+				},
+				lastNamesDataset: {
+					"Müller", "Schmidt", "Schneider", "Fischer", "Weber", "Meyer", "Wagner", "Becker", "Schulz", "Hoffmann",
+					"Schäfer", "Koch", "Bauer", "Richter", "Klein", "Wolf", "Schröder", "Neumann", "Schwarz", "Zimmermann",
+					"Braun", "Krüger", "Hofmann", "Hartmann", "Lange", "Schmitt", "Werner", "Krause", "Meier", "Lehmann",
+				},
+				wordsDataset: {
+					"apfel", "arbeit", "baum", "berg", "blume", "brot", "buch", "dorf", "feld", "fenster",
+					"garten", "haus", "himmel", "hund", "katze", "kirche", "licht", "meer", "morgen", "nacht",
+					"regen", "schule", "see", "sonne", "stadt", "straße", "tisch", "wald", "wasser", "zeit",
+				},
+				streetNamesDataset: {
+					"Hauptstraße", "Bahnhofstraße", "Schulstraße", "Gartenstraße", "Dorfstraße",
+					"Bergstraße", "Lindenstraße", "Kirchstraße", "Goethestraße", "Schillerstraße",
+					"Am Markt", "Waldweg", "Ringstraße", "Mühlenweg", "Friedrichstraße",
+				},
+				buildingNumberFormatsDataset:   {"%", "%#", "%##", "%a"},
+				secondaryAddressFormatsDataset: {"Wohnung %", "Wohnung %#", "%. OG"},
+				currenciesDataset:              {"EUR"},
+			},
+			bbox:          &[4]float32{47.27, 5.87, 55.06, 15.04},
+			country:       country{"Deutschland", "DE", "DEU"},
+			streetFormat:  "{street_name} {building_number}",
+			addressFormat: "{street_address}\n{postcode} {city}\n{country}",
+			places: []place{
+				{"Berlin", "Berlin", "BE", "10###", 52.52, 13.405},
+				{"Hamburg", "Hamburg", "HH", "20###", 53.5511, 9.9937},
+				{"München", "Bayern", "BY", "80###", 48.1351, 11.582},
+				{"Köln", "Nordrhein-Westfalen", "NW", "50###", 50.9375, 6.9603},
+				{"Frankfurt am Main", "Hessen", "HE", "60###", 50.1109, 8.6821},
+				{"Stuttgart", "Baden-Württemberg", "BW", "70###", 48.7758, 9.1829},
+				{"Düsseldorf", "Nordrhein-Westfalen", "NW", "40###", 51.2277, 6.7735},
+				{"Leipzig", "Sachsen", "SN", "04###", 51.3397, 12.3731},
+				{"Dresden", "Sachsen", "SN", "01###", 51.0504, 13.7373},
+				{"Hannover", "Niedersachsen", "NI", "30###", 52.3759, 9.732},
+				{"Bremen", "Bremen", "HB", "28###", 53.0793, 8.8017},
+				{"Nürnberg", "Bayern", "BY", "90###", 49.4521, 11.0767},
+			},
+			moneyFormat: moneyFormat{decimal: ",", group: ".", pattern: "# ¤"},
+		},
+		{
+			name: "fr_FR",
+			datasets: map[string][]string{
+				titlesMaleDataset:   {"M.", "Dr", "Pr", "Me"},
+				titlesFemaleDataset: {"Mme", "Mlle", "Dr", "Pr", "Me"},
+				firstNamesMaleDataset: {
+					"Alexandre", "Antoine", "Arthur", "Baptiste", "Benoît", "Clément", "Étienne", "François", "Gabriel", "Guillaume",
+					"Hugo", "Jules", "Julien", "Louis", "Lucas", "Mathieu", "Nicolas", "Olivier", "Philippe", "Pierre",
+					"Raphaël", "Sébastien", "Thomas", "Théo", "Vincent",
+				},
+				firstNamesFemaleDataset: {
+					"Alice", "Amélie", "Anne", "Camille", "Catherine", "Chloé", "Claire", "Élise", "Emma", "Hélène",
+					"Inès", "Isabelle", "Jade", "Juliette", "Léa", "Louise", "Manon", "Marie", "Mathilde", "Nathalie",
+					"Sophie", "Sylvie", "Valérie", "Zoé",
+				},
+				lastNamesDataset: {
+					"Martin", "Bernard", "Thomas", "Petit", "Robert", "Richard", "Durand", "Dubois", "Moreau", "Laurent",
+					"Simon", "Michel", "Lefèvre", "Leroy", "Roux", "David", "Bertrand", "Morel", "Fournier", "Girard",
+					"Bonnet", "Dupont", "Lambert", "Fontaine", "Rousseau", "Vincent", "Muller", "Lefebvre", "Faure", "Mercier",
+				},
+				wordsDataset: {
+					"arbre", "bateau", "chemin", "ciel", "château", "école", "eau", "étoile", "fenêtre", "fleur",
+					"forêt", "jardin", "jour", "lumière", "livre", "maison", "matin", "mer", "montagne", "nuit",
+					"pain", "pluie", "pont", "rivière", "route", "soleil", "table", "temps", "ville", "vent",
+				},
+				streetNamesDataset: {
+					"rue de la Paix", "rue Victor Hugo", "avenue des Champs-Élysées", "boulevard Saint-Germain", "rue de la République",
+					"place de la Liberté", "rue Jean Jaurès", "avenue Foch", "rue Pasteur", "rue du Moulin",
+					"chemin des Vignes", "rue de l'Église", "boulevard Voltaire", "rue Nationale",
+				},
+				buildingNumberFormatsDataset:   {"%", "%#", "%##", "% bis"},
+				secondaryAddressFormatsDataset: {"Appt. %#", "Bât. %", "Étage %"},
+				currenciesDataset:              {"EUR"},
+			},
+			bbox:          &[4]float32{42.33, -4.79, 51.09, 8.23},
+			country:       country{"France", "FR", "FRA"},
+			streetFormat:  "{building_number} {street_name}",
+			addressFormat: "{street_address}\n{postcode} {city}\n{country}",
+			places: []place{
+				{"Paris", "Île-de-France", "IDF", "750##", 48.8566, 2.3522},
+				{"Marseille", "Provence-Alpes-Côte d'Azur", "PAC", "130##", 43.2965, 5.3698},
+				{"Lyon", "Auvergne-Rhône-Alpes", "ARA", "6900#", 45.764, 4.8357},
+				{"Toulouse", "Occitanie", "OCC", "310##", 43.6047, 1.4442},
+				{"Nice", "Provence-Alpes-Côte d'Azur", "PAC", "060##", 43.7102, 7.262},
+				{"Nantes", "Pays de la Loire", "PDL", "440##", 47.2184, -1.5536},
+				{"Strasbourg", "Grand Est", "GES", "670##", 48.5734, 7.7521},
+				{"Montpellier", "Occitanie", "OCC", "340##", 43.6108, 3.8767},
+				{"Bordeaux", "Nouvelle-Aquitaine", "NAQ", "330##", 44.8378, -0.5792},
+				{"Lille", "Hauts-de-France", "HDF", "590##", 50.6292, 3.0573},
+				{"Rennes", "Bretagne", "BRE", "350##", 48.1173, -1.6778},
+			},
+			moneyFormat: moneyFormat{decimal: ",", group: " ", pattern: "# ¤"},
+		},
+		{
+			name: "es_ES",
+			datasets: map[string][]string{
+				titlesMaleDataset:   {"Sr.", "Dr.", "D."},
+				titlesFemaleDataset: {"Sra.", "Srta.", "Dra.", "Dña."},
+				firstNamesMaleDataset: {
+					"Alejandro", "Álvaro", "Antonio", "Carlos", "Daniel", "David", "Diego", "Fernando", "Francisco", "Hugo",
+					"Javier", "Jesús", "Jorge", "José", "Juan", "Luis", "Manuel", "Miguel", "Pablo", "Pedro",
+					"Rafael", "Ramón", "Sergio", "Víctor",
+				},
+				firstNamesFemaleDataset: {
+					"Ana", "Carmen", "Cristina", "Elena", "Isabel", "Laura", "Lucía", "María", "Marta", "Paula",
+					"Pilar", "Raquel", "Rosa", "Sara", "Sofía", "Teresa", "Julia", "Irene", "Alba", "Beatriz",
+				},
+				lastNamesDataset: {
+					"García", "Rodríguez", "González", "Fernández", "López", "Martínez", "Sánchez", "Pérez", "Gómez", "Martín",
+					"Jiménez", "Ruiz", "Hernández", "Díaz", "Moreno", "Muñoz", "Álvarez", "Romero", "Alonso", "Gutiérrez",
+					"Navarro", "Torres", "Domínguez", "Vázquez", "Ramos", "Gil", "Ramírez", "Serrano", "Blanco", "Molina",
+				},
+				wordsDataset: {
+					"agua", "árbol", "camino", "casa", "cielo", "ciudad", "día", "escuela", "estrella", "flor",
+					"fuego", "jardín", "libro", "luna", "luz", "mañana", "mar", "mesa", "montaña", "mundo",
+					"noche", "pan", "puente", "puerta", "río", "sol", "tiempo", "tierra", "ventana", "viento",
+				},
+				streetNamesDataset: {
+					"Calle Mayor", "Calle Real", "Gran Vía", "Avenida de la Constitución", "Calle del Sol",
+					"Plaza de España", "Calle de Alcalá", "Paseo de la Castellana", "Calle Nueva", "Avenida de América",
+					"Calle de la Paz", "Calle San Juan", "Calle del Carmen",
+				},
+				buildingNumberFormatsDataset:   {"%", "%#", "%##"},
+				secondaryAddressFormatsDataset: {"Piso %", "Puerta %", "Piso %, puerta %"},
+				currenciesDataset:              {"EUR"},
+			},
+			bbox:          &[4]float32{36.0, -9.3, 43.79, 3.32},
+			country:       country{"España", "ES", "ESP"},
+			streetFormat:  "{street_name}, {building_number}",
+			addressFormat: "{street_address}\n{postcode} {city}\n{country}",
+			places: []place{
+				{"Madrid", "Madrid", "M", "280##", 40.4168, -3.7038},
+				{"Barcelona", "Barcelona", "B", "080##", 41.3851, 2.1734},
+				{"Valencia", "Valencia", "V", "460##", 39.4699, -0.3763},
+				{"Sevilla", "Sevilla", "SE", "410##", 37.3891, -5.9845},
+				{"Zaragoza", "Zaragoza", "Z", "500##", 41.6488, -0.8891},
+				{"Málaga", "Málaga", "MA", "290##", 36.7213, -4.4214},
+				{"Murcia", "Murcia", "MU", "300##", 37.9922, -1.1307},
+				{"Palma", "Illes Balears", "PM", "070##", 39.5696, 2.6502},
+				{"Bilbao", "Bizkaia", "BI", "480##", 43.263, -2.935},
+				{"Valladolid", "Valladolid", "VA", "470##", 41.6523, -4.7245},
+			},
+			moneyFormat: moneyFormat{decimal: ",", group: ".", pattern: "# ¤"},
+		},
+		{
+			name:            "ja_JP",
+			familyNameFirst: true,
+			datasets: map[string][]string{
+				firstNamesMaleDataset: {
+					"翔太", "大輔", "健太", "拓也", "直樹", "和也", "達也", "大樹", "悠真", "蓮",
+					"陽翔", "湊", "大和", "隆", "誠", "浩", "修", "学", "亮", "翼",
+				},
+				firstNamesFemaleDataset: {
+					"陽菜", "結衣", "葵", "さくら", "美咲", "愛", "彩", "七海", "花子", "優子",
+					"恵", "真由美", "由美", "裕子", "明美", "芽依", "凛", "美羽", "結菜", "莉子",
+				},
+				lastNamesDataset: {
+					"佐藤", "鈴木", "高橋", "田中", "伊藤", "渡辺", "山本", "中村", "小林", "加藤",
+					"吉田", "山田", "佐々木", "山口", "松本", "井上", "木村", "林", "斎藤", "清水",
+				},
+				wordsDataset: {
+					"空", "海", "山", "川", "花", "木", "森", "月", "星", "雨",
+					"風", "雪", "光", "道", "家", "町", "春", "夏", "秋", "冬",
+				},
+				streetNamesDataset: {
+					"中央", "本町", "栄町", "緑町", "旭町",
+					"桜町", "東町", "西町", "南町", "北町",
+					"幸町", "若葉",
+				},
+				buildingNumberFormatsDataset:   {"%丁目%-%", "%丁目%-%#", "%丁目%#-%"},
+				secondaryAddressFormatsDataset: {"%0%号室", "%#号室"},
+				currenciesDataset:              {"JPY"},
+			},
+			bbox:          &[4]float32{30.99, 129.41, 45.55, 145.54},
+			country:       country{"日本", "JP", "JPN"},
+			streetFormat:  "{street_name}{building_number}",
+			addressFormat: "〒{postcode}\n{state}{city}{street_address}\n{country}",
+			places: []place{
+				{"新宿区", "東京都", "13", "160-####", 35.6938, 139.7034},
+				{"渋谷区", "東京都", "13", "150-####", 35.664, 139.6982},
+				{"横浜市", "神奈川県", "14", "220-####", 35.4437, 139.638},
+				{"大阪市", "大阪府", "27", "530-####", 34.6937, 135.5023},
+				{"名古屋市", "愛知県", "23", "450-####", 35.1815, 136.9066},
+				{"札幌市", "北海道", "01", "060-####", 43.0618, 141.3545},
+				{"福岡市", "福岡県", "40", "810-####", 33.5904, 130.4017},
+				{"神戸市", "兵庫県", "28", "650-####", 34.6901, 135.1955},
+				{"京都市", "京都府", "26", "600-####", 35.0116, 135.7681},
+				{"仙台市", "宮城県", "04", "980-####", 38.2682, 140.8694},
+				{"広島市", "広島県", "34", "730-####", 34.3853, 132.4553},
+			},
+			moneyFormat: moneyFormat{decimal: ".", group: ",", pattern: "¤#"},
+		},
+		{
+			name: "pt_BR",
+			datasets: map[string][]string{
+				titlesMaleDataset:   {"Sr.", "Dr.", "Prof."},
+				titlesFemaleDataset: {"Sra.", "Srta.", "Dra.", "Profa."},
+				firstNamesMaleDataset: {
+					"Antônio", "Arthur", "Bernardo", "Bruno", "Carlos", "Davi", "Eduardo", "Felipe", "Francisco", "Gabriel",
+					"Gustavo", "Heitor", "João", "José", "Lucas", "Luiz", "Marcelo", "Mateus", "Miguel", "Paulo",
+					"Pedro", "Rafael", "Rodrigo", "Thiago",
+				},
+				firstNamesFemaleDataset: {
+					"Alice", "Ana", "Beatriz", "Camila", "Cecília", "Fernanda", "Gabriela", "Helena", "Isabela", "Júlia",
+					"Larissa", "Laura", "Letícia", "Luana", "Manuela", "Maria", "Mariana", "Patrícia", "Sofia", "Valentina",
+				},
+				lastNamesDataset: {
+					"Silva", "Santos", "Oliveira", "Souza", "Rodrigues", "Ferreira", "Alves", "Pereira", "Lima", "Gomes",
+					"Costa", "Ribeiro", "Martins", "Carvalho", "Almeida", "Lopes", "Soares", "Fernandes", "Vieira", "Barbosa",
+					"Rocha", "Dias", "Nascimento", "Andrade", "Moreira", "Nunes", "Marques", "Machado", "Mendes", "Freitas",
+				},
+				wordsDataset: {
+					"água", "árvore", "cachorro", "caminho", "casa", "céu", "cidade", "dia", "escola", "estrela",
+					"flor", "janela", "jardim", "livro", "lua", "luz", "manhã", "mar", "mesa", "montanha",
+					"mundo", "noite", "pão", "ponte", "porta", "praia", "rio", "sol", "tempo", "vento",
+				},
+				streetNamesDataset: {
+					"Rua das Flores", "Avenida Paulista", "Rua Sete de Setembro", "Rua XV de Novembro", "Avenida Brasil",
+					"Rua Dom Pedro II", "Avenida Atlântica", "Rua da Consolação", "Rua Augusta", "Avenida Getúlio Vargas",
+					"Rua São João", "Rua Tiradentes",
+				},
+				buildingNumberFormatsDataset:   {"%", "%#", "%##", "%###"},
+				secondaryAddressFormatsDataset: {"Apto. %#", "Apto. %##", "Casa %", "Bloco %"},
+				currenciesDataset:              {"BRL"},
+			},
+			bbox:          &[4]float32{-33.75, -73.99, 5.27, -34.79},
+			country:       country{"Brasil", "BR", "BRA"},
+			streetFormat:  "{street_name}, {building_number}",
+			addressFormat: "{street_address}\n{city} - {state_code}\n{postcode}\n{country}",
+			places: []place{
+				{"São Paulo", "São Paulo", "SP", "01###-###", -23.5505, -46.6333},
+				{"Rio de Janeiro", "Rio de Janeiro", "RJ", "20###-###", -22.9068, -43.1729},
+				{"Belo Horizonte", "Minas Gerais", "MG", "30###-###", -19.9167, -43.9345},
+				{"Brasília", "Distrito Federal", "DF", "70###-###", -15.7939, -47.8828},
+				{"Salvador", "Bahia", "BA", "40###-###", -12.9714, -38.5014},
+				{"Fortaleza", "Ceará", "CE", "60###-###", -3.7319, -38.5267},
+				{"Curitiba", "Paraná", "PR", "80###-###", -25.4284, -49.2733},
+				{"Recife", "Pernambuco", "PE", "50###-###", -8.0476, -34.877},
+				{"Porto Alegre", "Rio Grande do Sul", "RS", "90###-###", -30.0346, -51.2177},
+				{"Manaus", "Amazonas", "AM", "69###-###", -3.119, -60.0217},
+			},
+			moneyFormat: moneyFormat{decimal: ",", group: ".", pattern: "¤ #"},
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package faker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type LocaleRecord struct {
+	FirstName string  `faker:"first_name"`
+	LastName  string  `faker:"last_name"`
+	Word      string  `faker:"word"`
+	Currency  string  `faker:"currency"`
+	Phone     string  `faker:"phone_number"`
+	TollFree  string  `faker:"toll_free_number"`
+	E164      string  `faker:"e_164_phone_number"`
+	Latitude  float64 `faker:"lat"`
+	Longitude float64 `faker:"long"`
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestWithLocale(t *testing.T) {
+	for _, name := range []string{"de_DE", "fr_FR", "es_ES", "ja_JP", "pt_BR"} {
+		l, err := findLocale(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 20; i++ {
+			var r LocaleRecord
+			if err := FakeData(&r, WithLocale(name)); err != nil {
+				t.Fatal(err)
+			}
+			if !contains(l.datasets[firstNamesDataset], r.FirstName) {
+				t.Errorf("%s: expected a first name of the locale, but got %s", name, r.FirstName)
+			}
+			if !contains(l.datasets[lastNamesDataset], r.LastName) {
+				t.Errorf("%s: expected a last name of the locale, but got %s", name, r.LastName)
+			}
+			if !contains(l.datasets[wordsDataset], r.Word) {
+				t.Errorf("%s: expected a word of the locale, but got %s", name, r.Word)
+			}
+			if !contains(l.datasets[currenciesDataset], r.Currency) {
+				t.Errorf("%s: expected a currency of the locale, but got %s", name, r.Currency)
+			}
+			if strings.Contains(r.Phone+r.TollFree+r.E164, "#") {
+				t.Errorf("%s: expected formatted phone numbers, but got %s %s %s", name, r.Phone, r.TollFree, r.E164)
+			}
+			if float32(r.Latitude) < l.bbox[0] || float32(r.Latitude) > l.bbox[2] ||
+				float32(r.Longitude) < l.bbox[1] || float32(r.Longitude) > l.bbox[3] {
+				t.Errorf("%s: expected coordinates in the locale, but got %f,%f", name, r.Latitude, r.Longitude)
+			}
+		}
+	}
+}
+
+func TestWithLocaleFallback(t *testing.T) {
+	var r struct {
+		Title string `faker:"title_male"`
+		Name  string `faker:"name"`
+	}
+	if err := FakeData(&r, WithLocale("ja_JP")); err != nil {
+		t.Fatal(err)
+	}
+	if !contains(titlesMale, r.Title) {
+		t.Errorf("expected a title of %s, but got %s", DefaultLocale, r.Title)
+	}
+	parts := strings.Split(r.Name, " ")
+	if len(parts) != 2 || !contains(locales["ja_JP"].datasets[lastNamesDataset], parts[0]) {
+		t.Errorf("expected the family name first, but got %s", r.Name)
+	}
+}
+
+func TestSetLocale(t *testing.T) {
+	defer func() {
+		if err := SetLocale(DefaultLocale); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := SetLocale("pt-BR"); err != nil {
+		t.Fatal(err)
+	}
+	if c := Currency(); c != "BRL" {
+		t.Errorf("expected BRL, but got %s", c)
+	}
+	var r LocaleRecord
+	if err := FakeData(&r, WithLocale("de")); err != nil {
+		t.Fatal(err)
+	}
+	if r.Currency != "EUR" {
+		t.Errorf("expected WithLocale to take precedence, but got %s", r.Currency)
+	}
+
+	if err := SetLocale("xx_XX"); err == nil || err.Error() != fmt.Sprintf(ErrUnknownLocale, "xx_XX") {
+		t.Errorf("expected an unknown locale error, but got %v", err)
+	}
+	if err := FakeData(&r, WithLocale("xx")); err == nil {
+		t.Error("expected an unknown locale error")
+	}
+}
+
+func TestLocales(t *testing.T) {
+	names := Locales()
+	for _, name := range []string{DefaultLocale, "de_DE", "fr_FR", "es_ES", "ja_JP", "pt_BR"} {
+		if !contains(names, name) {
+			t.Errorf("expected %s in %v", name, names)
+		}
+	}
+}
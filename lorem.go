@@ -2,7 +2,6 @@ package faker
 
 import (
 	"fmt"
-	"math/rand"
 	"reflect"
 	"strings"
 )
@@ -57,6 +56,9 @@ type DataFaker interface {
 
 // SetDataFaker sets Custom data in lorem
 func SetDataFaker(d DataFaker) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	lorem = d
 }
 
@@ -73,10 +75,11 @@ func GetLorem() DataFaker {
 
 // Lorem struct
 type Lorem struct {
+	provider
 }
 
 func (l Lorem) word() string {
-	return randomElementFromSliceString(wordList)
+	return randomElementFromSliceString(l.rnd(), l.dataset(wordsDataset))
 }
 
 // Word returns a word from the wordList const
@@ -94,13 +97,14 @@ func Word() string {
 
 func (l Lorem) sentence() string {
 	sentence := ""
-	r, _ := RandomInt(1, 6)
+	words := l.dataset(wordsDataset)
+	r, _ := randomInt(l.rnd(), 1, 6)
 	size := len(r)
 	for key, val := range r {
 		if key == 0 {
-			sentence += strings.Title(wordList[val])
+			sentence += strings.Title(words[val%len(words)])
 		} else {
-			sentence += wordList[val]
+			sentence += words[val%len(words)]
 		}
 		if key != size-1 {
 			sentence += " "
@@ -125,7 +129,7 @@ func Sentence() string {
 
 func (l Lorem) paragraph() string {
 	paragraph := ""
-	size := rand.Intn(10) + 1
+	size := l.rnd().Intn(10) + 1
 	for i := 0; i < size; i++ {
 		paragraph += l.sentence()
 		if i != size-1 {
@@ -0,0 +1,127 @@
+package faker
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+)
+
+// FakeMany fakes n values and stores them in the slice pointed by out, e.g.
+//
+//	var users []User
+//	err := faker.FakeMany(&users, 1000000, faker.Workers(8), faker.Seed(42))
+//
+// The records are generated by the number of goroutines set with Workers. Each record draws from its own source,
+// derived from the master source set with Seed, or from the global one, so the same seed generates the same records
+// whatever the number of workers. Unique values are inserted in the order of the records: a record whose unique value
+// was taken by a record before it is generated again once the latter is inserted. The times of a Sequence, see Monotonic,
+// are taken in the order of the records too.
+func FakeMany(out interface{}, n int, opt ...Option) error {
+	if n < 0 {
+		return fmt.Errorf(ErrSmallerThanZero, n)
+	}
+	t := reflect.TypeOf(out)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf(ErrWrongManyType, out)
+	}
+	if reflect.ValueOf(out).IsNil() {
+		return fmt.Errorf(ErrNotSupportedPointer, t.Elem().String())
+	}
+
+	opts := newOptions(opt...)
+	workers := opts.workers
+	if workers < 1 {
+		workers = 1
+	}
+	master := opts.r
+	if master == nil {
+		master = rand.New(rand.NewSource(globalRand.Int63()))
+	}
+	seeds := make([]int64, n)
+	for i := range seeds {
+		seeds[i] = master.Int63()
+	}
+
+	var seqs []*Sequence
+	if seq := opts.provider().sequence(); seq != nil {
+		seqs = seq.recordSequences(n)
+	}
+
+	list := reflect.MakeSlice(t.Elem(), n, n)
+	claims := make([][]uniqueClaim, n)
+	errs := make([]error, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// the source of the worker is seeded again for each record
+			r := rand.New(rand.NewSource(0))
+			for i := range indexes {
+				r.Seed(seeds[i])
+				record := opts.record(r)
+				record.deferUnique = true
+				if seqs != nil {
+					record.seq = seqs[i]
+				}
+				errs[i] = fakeData(list.Index(i).Addr().Interface(), record)
+				claims[i] = record.claims
+				if seqs != nil {
+					seqs[i].finish()
+				}
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i := range claims {
+		if errs[i] != nil {
+			return errs[i]
+		}
+		if insertClaims(claims[i]) {
+			continue
+		}
+		// A record generated concurrently took one of its unique values. It is generated again from a zero value,
+		// like the first time, so that Merge and keep don't keep the values of the rejected record.
+		retry := opts.record(rand.New(rand.NewSource(seeds[i])))
+		if seqs != nil {
+			retry.seq = seqs[i].replay()
+		}
+		record := reflect.New(t.Elem().Elem())
+		if err := fakeData(record.Interface(), retry); err != nil {
+			return err
+		}
+		list.Index(i).Set(record.Elem())
+	}
+	reflect.ValueOf(out).Elem().Set(list)
+	return nil
+}
+
+// record returns the options of a record generated by FakeMany: the options of the call drawing from r
+func (o *options) record(r *rand.Rand) *options {
+	record := *o
+	record.r = r
+	record.used = nil
+	return &record
+}
+
+// insertClaims inserts the unique values claimed by a call, all or none of them.
+// It returns false if one of them was already generated.
+func insertClaims(claims []uniqueClaim) bool {
+	if len(claims) == 0 {
+		return true
+	}
+	scopes := make([]string, len(claims))
+	values := make([]interface{}, len(claims))
+	for i, claim := range claims {
+		scopes[i] = claim.scope
+		values[i] = claim.value
+	}
+	return insertUniqueAll(scopes, values) < 0
+}
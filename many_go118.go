@@ -0,0 +1,15 @@
+//go:build go1.18
+// +build go1.18
+
+package faker
+
+// GenerateN fakes n values of type T with FakeMany and returns them, e.g.
+//
+//	users, err := faker.GenerateN[User](1000, faker.Workers(8))
+func GenerateN[T any](n int, opt ...Option) ([]T, error) {
+	var res []T
+	if err := FakeMany(&res, n, opt...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,23 @@
+//go:build go1.18
+// +build go1.18
+
+package faker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerateN(t *testing.T) {
+	records, err := GenerateN[ManyRecord](50, Seed(3), Workers(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected []ManyRecord
+	if err := FakeMany(&expected, 50, Seed(3)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Error("expected GenerateN to generate the records of FakeMany")
+	}
+}
@@ -0,0 +1,175 @@
+package faker
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+type ManyRecord struct {
+	Name  string `faker:"name"`
+	Email string `faker:"email"`
+	Phone string `faker:"phone_number"`
+	ID    string `faker:"uuid_hyphenated"`
+	Age   int
+	Score float64
+	Tags  []string
+	Login string `faker:"username,from=Name"`
+}
+
+func TestFakeManyDeterministic(t *testing.T) {
+	var sequential, parallel, other []ManyRecord
+	if err := FakeMany(&sequential, 200, Seed(42)); err != nil {
+		t.Fatal(err)
+	}
+	if err := FakeMany(&parallel, 200, Seed(42), Workers(8)); err != nil {
+		t.Fatal(err)
+	}
+	if err := FakeMany(&other, 200, Seed(43), Workers(8)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(parallel) != 200 {
+		t.Fatalf("expected 200 records, but got %d", len(parallel))
+	}
+	if !reflect.DeepEqual(sequential, parallel) {
+		t.Error("expected the same records whatever the number of workers")
+	}
+	if reflect.DeepEqual(parallel, other) {
+		t.Error("expected other records with another seed")
+	}
+}
+
+func TestFakeManyUnique(t *testing.T) {
+	type Record struct {
+		Email string `faker:"email,unique"`
+		Day   string `faker:"day_of_week,unique"`
+	}
+	defer ResetUnique()
+
+	var sequential []Record
+	if err := FakeMany(&sequential, 7, Seed(1)); err != nil {
+		t.Fatal(err)
+	}
+	ResetUnique()
+	var parallel []Record
+	if err := FakeMany(&parallel, 7, Seed(1), Workers(4)); err != nil {
+		t.Fatal(err)
+	}
+
+	days := map[string]bool{}
+	for _, record := range parallel {
+		days[record.Day] = true
+	}
+	if len(days) != 7 {
+		t.Errorf("expected 7 distinct days, but got %v", parallel)
+	}
+	if !reflect.DeepEqual(sequential, parallel) {
+		t.Errorf("expected the same records whatever the number of workers, got %v and %v", sequential, parallel)
+	}
+
+	var more []Record
+	err := FakeMany(&more, 1, Seed(2), Workers(4))
+	if err == nil || err.Error() != fmt.Sprintf(ErrUniqueExhausted, 7, "Day") {
+		t.Errorf("expected exhausted error on Day, but got %v", err)
+	}
+}
+
+func TestFakeManyUniqueRetry(t *testing.T) {
+	type Record struct {
+		N int `faker:"boundary_start=0, boundary_end=50,unique"`
+	}
+	type KeptRecord struct {
+		N int `faker:"boundary_start=0, boundary_end=50,unique,keep"`
+	}
+	defer ResetUnique()
+
+	// the records whose values were taken by concurrent records are generated again from scratch
+	var merged []Record
+	if err := FakeMany(&merged, 40, Merge(), Workers(4), Seed(1)); err != nil {
+		t.Fatal(err)
+	}
+	var kept []KeptRecord
+	if err := FakeMany(&kept, 40, Workers(4), Seed(1)); err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for i := range merged {
+		for _, key := range []string{fmt.Sprint("merged", merged[i].N), fmt.Sprint("kept", kept[i].N)} {
+			if seen[key] {
+				t.Errorf("expected unique values, but got %s twice", key)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestFakeManyPointers(t *testing.T) {
+	var records []*ManyRecord
+	if err := FakeMany(&records, 10, Workers(3)); err != nil {
+		t.Fatal(err)
+	}
+	for _, record := range records {
+		if record == nil || record.Email == "" {
+			t.Fatalf("expected faked records, but got %v", records)
+		}
+	}
+}
+
+func TestFakeManyErrors(t *testing.T) {
+	var records []ManyRecord
+	if err := FakeMany(records, 1); err == nil || err.Error() != fmt.Sprintf(ErrWrongManyType, records) {
+		t.Errorf("expected error on slice, but got %v", err)
+	}
+	var record ManyRecord
+	if err := FakeMany(&record, 1); err == nil {
+		t.Error("expected error on pointer to struct, but got nil")
+	}
+	if err := FakeMany(&records, -1); err == nil {
+		t.Error("expected error on negative size, but got nil")
+	}
+}
+
+func TestSeed(t *testing.T) {
+	var a, b ManyRecord
+	if err := FakeData(&a, Seed(7)); err != nil {
+		t.Fatal(err)
+	}
+	if err := FakeData(&b, Seed(7)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expected the same values with the same seed, got %v and %v", a, b)
+	}
+}
+
+func TestFakeManyMemory(t *testing.T) {
+	type Point struct {
+		X, Y  int
+		Label float64
+	}
+	const n = 10000
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	var points []Point
+	if err := FakeMany(&points, n, Workers(4), Seed(1)); err != nil {
+		t.Fatal(err)
+	}
+	runtime.ReadMemStats(&after)
+	// a source per record would take about 5KB alone
+	if perRecord := (after.TotalAlloc - before.TotalAlloc) / n; perRecord > 2048 {
+		t.Errorf("expected less than 2KB allocated per record, but got %d bytes", perRecord)
+	}
+}
+
+func BenchmarkFakeMany(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var records []ManyRecord
+		if err := FakeMany(&records, 1000, Workers(8)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
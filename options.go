@@ -0,0 +1,268 @@
+package faker
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Option customizes a single FakeData call
+type Option func(*options)
+
+// options holds the settings of a single FakeData call
+type options struct {
+	overrides map[string]interface{}
+	used      map[string]bool
+	with      []interface{}
+	merge     bool
+	r         *rand.Rand
+	workers   int
+	ctx       context.Context
+	locale    string
+	loc       *locale
+	// params are the tag parameters of the field being generated
+	params map[string]string
+	seq    *Sequence
+	clock  func() time.Time
+
+	// deferUnique collects the unique values of the call in claims instead of inserting them, see FakeMany
+	deferUnique bool
+	claims      []uniqueClaim
+	// held keeps the unique values of the fields of a struct with unique_together groups, by field index,
+	// until its groups are accepted, see uniqueTogether
+	held map[int]uniqueClaim
+	// card is the card network of the card fields of the struct being generated, see Payment.network
+	card *creditCard
+
+	rootType    reflect.Type
+	withApplied bool
+}
+
+func newOptions(opts ...Option) *options {
+	o := &options{
+		overrides: map[string]interface{}{},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// With runs fn on the generated value before its AfterFake hook is called, so it can set the fields the test cares about.
+// fn must be a func taking a pointer of the type passed to FakeData, e.g.
+//
+//	faker.FakeData(&u, faker.With(func(u *User) { u.Role = "admin" }))
+func With(fn interface{}) Option {
+	return func(o *options) {
+		o.with = append(o.with, fn)
+	}
+}
+
+// Override sets the fields found at the given paths instead of generating them.
+// Paths are field names separated by dots, slice elements are addressed by their index, e.g.
+//
+//	faker.FakeData(&u, faker.Override(map[string]interface{}{
+//		"Address.Country": "DE",
+//		"Roles":           []string{"admin"},
+//		"Orders[0].Total": 42,
+//	}))
+//
+// Generated slices are made long enough to hold the addressed elements.
+func Override(values map[string]interface{}) Option {
+	return func(o *options) {
+		for path, val := range values {
+			o.overrides[path] = val
+		}
+	}
+}
+
+// Merge keeps the values already set in the value passed to FakeData and only fakes its zero valued fields,
+// going down into nested structs and pointers. Non empty slices and maps are kept as they are.
+func Merge() Option {
+	return func(o *options) {
+		o.merge = true
+	}
+}
+
+// Seed makes the call draw all its random values from a source seeded with seed instead of the global one,
+// so the same seed always generates the same value. Providers added with AddProvider keep their own randomness.
+// With FakeMany, seed is the master seed the source of each record is derived from.
+func Seed(seed int64) Option {
+	return func(o *options) {
+		o.r = rand.New(rand.NewSource(seed))
+	}
+}
+
+// Workers sets how many goroutines FakeMany generates the records with, 1 by default.
+// It is ignored by FakeData.
+func Workers(n int) Option {
+	return func(o *options) {
+		o.workers = n
+	}
+}
+
+// validate checks the options against the type of the value passed to FakeData
+func (o *options) validate(t reflect.Type) error {
+	o.rootType = t
+	if o.locale != "" {
+		l, err := findLocale(o.locale)
+		if err != nil {
+			return err
+		}
+		o.loc = l
+	}
+	for _, fn := range o.with {
+		ft := reflect.TypeOf(fn)
+		if ft == nil || ft.Kind() != reflect.Func || ft.NumIn() != 1 || ft.NumOut() != 0 || ft.In(0) != t {
+			return fmt.Errorf(ErrWrongWithFunc, t.String(), ft)
+		}
+	}
+	return nil
+}
+
+// applyWith calls the With functions on v, a pointer of the type passed to FakeData.
+// They are only called once, for the root value.
+func (o *options) applyWith(v reflect.Value) {
+	if o.withApplied || v.Type() != o.rootType {
+		return
+	}
+	o.withApplied = true
+	for _, fn := range o.with {
+		reflect.ValueOf(fn).Call([]reflect.Value{v})
+	}
+}
+
+// provider returns the state of the call used by the built-in providers
+func (o *options) provider() provider {
+	return provider{r: o.r, loc: o.loc, params: o.params, seq: o.seq, clock: o.clock, card: o.card}
+}
+
+// tagFunction returns the provider of tag, the built-in ones being bound to the state of the call
+func (o *options) tagFunction(tag string) (TaggedFunction, bool) {
+	if bind, ok := builtinTags[tag]; ok {
+		return bind(o.provider()), true
+	}
+	mapperMu.RLock()
+	defer mapperMu.RUnlock()
+
+	if fn, ok := mapperContextTag[tag]; ok {
+		return func(v reflect.Value) (interface{}, error) {
+			return fn(o.context(), v)
+		}, true
+	}
+	fn, ok := mapperTag[tag]
+	return fn, ok
+}
+
+// context returns the context of the call, see FakeDataContext
+func (o *options) context() context.Context {
+	if o.ctx == nil {
+		return context.Background()
+	}
+	return o.ctx
+}
+
+// err returns the error of the context of the call once it is done
+func (o *options) err() error {
+	if o.ctx == nil {
+		return nil
+	}
+	return o.ctx.Err()
+}
+
+// override sets v from the override registered for path, if any
+func (o *options) override(v reflect.Value, path string) (bool, error) {
+	val, ok := o.overrides[path]
+	if !ok {
+		return false, nil
+	}
+	if o.used == nil {
+		o.used = map[string]bool{}
+	}
+	o.used[path] = true
+	if val == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return true, nil
+	}
+
+	rval := reflect.ValueOf(val)
+	t := v.Type()
+	switch {
+	case rval.Type().AssignableTo(t):
+		v.Set(rval)
+	case sameKind(rval.Type(), t) && rval.Type().ConvertibleTo(t):
+		v.Set(rval.Convert(t))
+	case t.Kind() == reflect.Ptr && sameKind(rval.Type(), t.Elem()) && rval.Type().ConvertibleTo(t.Elem()):
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(rval.Convert(t.Elem()))
+		v.Set(ptr)
+	default:
+		return true, fmt.Errorf(ErrWrongOverrideType, path, val, t.String())
+	}
+	return true, nil
+}
+
+// sliceLen returns the length of the slice generated at path: n, or more when overrides address a further index,
+// so that "Orders[3].Total" always finds a fourth element
+func (o *options) sliceLen(path string, n int) int {
+	prefix := path + "["
+	for p := range o.overrides {
+		if len(p) <= len(prefix) || p[:len(prefix)] != prefix {
+			continue
+		}
+		end := len(prefix)
+		for end < len(p) && p[end] != ']' {
+			end++
+		}
+		if i, err := strconv.Atoi(p[len(prefix):end]); err == nil && i >= n {
+			n = i + 1
+		}
+	}
+	return n
+}
+
+// unused returns an error naming the overrides that did not match any field
+func (o *options) unused() error {
+	paths := make([]string, 0)
+	for path := range o.overrides {
+		if !o.used[path] {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	sort.Strings(paths)
+	return fmt.Errorf(ErrOverrideNotFound, paths)
+}
+
+// sameKind tells if a value of type from can be converted to type to without changing its meaning,
+// e.g. an int to a float64 or a string to a custom string type, but not an int to a string
+func sameKind(from, to reflect.Type) bool {
+	return from.Kind() == to.Kind() || isNumber(from.Kind()) && isNumber(to.Kind())
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
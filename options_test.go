@@ -0,0 +1,208 @@
+package faker
+
+import (
+	"testing"
+)
+
+type OverrideAddress struct {
+	Street  string
+	Country string
+}
+
+type OverrideOrder struct {
+	Total float64
+}
+
+type OverrideUser struct {
+	Name      string
+	Role      string
+	Age       int
+	Nickname  *string
+	Address   OverrideAddress
+	Billing   *OverrideAddress
+	Orders    []OverrideOrder
+	Tags      []string
+	RoleCheck string
+}
+
+func (u *OverrideUser) AfterFake() error {
+	u.RoleCheck = u.Role
+	return nil
+}
+
+func TestWith(t *testing.T) {
+	u := OverrideUser{}
+	err := FakeData(&u, With(func(u *OverrideUser) {
+		u.Role = "admin"
+	}))
+	if err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if u.Role != "admin" {
+		t.Errorf("expected role admin, but got %s", u.Role)
+	}
+	if u.RoleCheck != "admin" {
+		t.Errorf("expected With to run before AfterFake, but got %s", u.RoleCheck)
+	}
+	if u.Name == "" {
+		t.Error("expected the other fields to be filled")
+	}
+}
+
+func TestWithOnSlice(t *testing.T) {
+	var tags []string
+	err := FakeData(&tags, With(func(tags *[]string) {
+		*tags = append(*tags, "extra")
+	}))
+	if err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if len(tags) == 0 || tags[len(tags)-1] != "extra" {
+		t.Errorf("expected With to be applied on the slice, got %v", tags)
+	}
+}
+
+func TestWithWrongFunc(t *testing.T) {
+	u := OverrideUser{}
+	for _, fn := range []interface{}{nil, "admin", func(u OverrideUser) {}, func(u *OverrideAddress) {}} {
+		if err := FakeData(&u, With(fn)); err == nil {
+			t.Errorf("expected error for With(%T), but got nil", fn)
+		}
+	}
+}
+
+func TestOverride(t *testing.T) {
+	u := OverrideUser{}
+	err := FakeData(&u, Override(map[string]interface{}{
+		"Role":            "admin",
+		"Age":             int64(42),
+		"Nickname":        "nick",
+		"Address.Country": "DE",
+		"Billing":         OverrideAddress{Street: "Main street"},
+		"Tags":            []string{"a", "b"},
+	}), With(func(u *OverrideUser) {
+		u.Age++
+	}))
+	if err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if u.Role != "admin" || u.RoleCheck != "admin" {
+		t.Errorf("expected role admin, but got %s", u.Role)
+	}
+	if u.Age != 43 {
+		t.Errorf("expected age 43, but got %d", u.Age)
+	}
+	if u.Nickname == nil || *u.Nickname != "nick" {
+		t.Errorf("expected nickname nick, but got %v", u.Nickname)
+	}
+	if u.Address.Country != "DE" || u.Address.Street == "" {
+		t.Errorf("expected country DE and a random street, but got %+v", u.Address)
+	}
+	if u.Billing == nil || *u.Billing != (OverrideAddress{Street: "Main street"}) {
+		t.Errorf("expected the billing address to be overridden, but got %+v", u.Billing)
+	}
+	if len(u.Tags) != 2 || u.Tags[0] != "a" || u.Tags[1] != "b" {
+		t.Errorf("expected tags [a b], but got %v", u.Tags)
+	}
+}
+
+func TestOverrideSliceElement(t *testing.T) {
+	if err := SetFixedMapAndSliceSize(3); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = SetRandomMapAndSliceSize(100) }()
+
+	u := OverrideUser{}
+	err := FakeData(&u, Override(map[string]interface{}{
+		"Orders[1].Total": 10,
+	}))
+	if err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if len(u.Orders) != 3 || u.Orders[1].Total != 10 {
+		t.Errorf("expected second order total 10, but got %+v", u.Orders)
+	}
+}
+
+func TestOverrideGrowsSlices(t *testing.T) {
+	// the example of Override, whatever the length of the generated slices
+	for i := 0; i < 2000; i++ {
+		u := OverrideUser{}
+		err := FakeData(&u, Override(map[string]interface{}{
+			"Address.Country": "DE",
+			"Tags":            []string{"admin"},
+			"Orders[0].Total": 42,
+		}))
+		if err != nil {
+			t.Fatal("Expected NoError, but Got Err: ", err)
+		}
+		if len(u.Orders) == 0 || u.Orders[0].Total != 42 || u.Address.Country != "DE" {
+			t.Fatalf("expected the overrides to be set, but got %+v", u)
+		}
+	}
+
+	if err := SetFixedMapAndSliceSize(2); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = SetRandomMapAndSliceSize(100) }()
+	u := OverrideUser{}
+	if err := FakeData(&u, Override(map[string]interface{}{"Orders[4].Total": 7})); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if len(u.Orders) != 5 || u.Orders[4].Total != 7 {
+		t.Errorf("expected the fifth order total 7, but got %+v", u.Orders)
+	}
+}
+
+func TestOverrideErrors(t *testing.T) {
+	u := OverrideUser{}
+	if err := FakeData(&u, Override(map[string]interface{}{"Role": 1})); err == nil {
+		t.Error("expected error on override with wrong type, but got nil")
+	}
+	if err := FakeData(&u, Override(map[string]interface{}{"Address.Missing": "DE"})); err == nil {
+		t.Error("expected error on override of an unknown field, but got nil")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	nickname := "nick"
+	u := OverrideUser{
+		Role:     "admin",
+		Nickname: &nickname,
+		Address:  OverrideAddress{Country: "DE"},
+		Billing:  &OverrideAddress{Street: "Main street"},
+		Tags:     []string{"a"},
+	}
+	if err := FakeData(&u, Merge()); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if u.Role != "admin" || u.Nickname != &nickname {
+		t.Errorf("expected the set fields to be kept, but got %+v", u)
+	}
+	if u.Name == "" {
+		t.Error("expected the zero fields to be faked")
+	}
+	if u.Address.Country != "DE" || u.Address.Street == "" {
+		t.Errorf("expected the nested struct to be merged, but got %+v", u.Address)
+	}
+	if u.Billing.Street != "Main street" || u.Billing.Country == "" {
+		t.Errorf("expected the nested pointer to be merged, but got %+v", u.Billing)
+	}
+	if len(u.Tags) != 1 || u.Tags[0] != "a" {
+		t.Errorf("expected the slice to be kept, but got %v", u.Tags)
+	}
+}
+
+func TestMergeKeepOnStruct(t *testing.T) {
+	type Sample struct {
+		Address OverrideAddress `faker:"keep"`
+		Items   []string        `faker:"keep"`
+	}
+	s := Sample{Address: OverrideAddress{Country: "DE"}}
+	if err := FakeData(&s, Merge()); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	if s.Address.Country != "DE" || s.Address.Street == "" {
+		t.Errorf("expected the nested struct to be merged, but got %+v", s.Address)
+	}
+}
@@ -1,8 +1,9 @@
 package faker
 
 import (
-	"math/rand"
+	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -13,23 +14,94 @@ const (
 
 // creditCard struct
 type creditCard struct {
-	ccType   string
-	length   int
-	prefixes []int
+	ccType    string
+	length    int
+	prefixes  []int
+	cvvLength int
 }
 
 var creditCards = map[string]creditCard{
-	"visa":             {"VISA", 16, []int{4539, 4556, 4916, 4532, 4929, 40240071, 4485, 4716, 4}},
-	"mastercard":       {"MasterCard", 16, []int{51, 52, 53, 54, 55}},
-	"american express": {"American Express", 15, []int{34, 37}},
-	"discover":         {"Discover", 16, []int{6011}},
-	"jcb":              {"JCB", 16, []int{3528, 3538, 3548, 3558, 3568, 3578, 3588}},
-	"diners club":      {"Diners Club", 14, []int{36, 38, 39}},
+	"visa":             {"VISA", 16, []int{4539, 4556, 4916, 4532, 4929, 40240071, 4485, 4716, 4}, 3},
+	"mastercard":       {"MasterCard", 16, append([]int{51, 52, 53, 54, 55}, prefixRange(2221, 2720)...), 3},
+	"american express": {"American Express", 15, []int{34, 37}, 4},
+	"discover":         {"Discover", 16, append([]int{6011, 65}, prefixRange(644, 649)...), 3},
+	"jcb":              {"JCB", 16, prefixRange(3528, 3589), 3},
+	"diners club":      {"Diners Club", 14, append([]int{36, 38, 39}, prefixRange(300, 305)...), 3},
+	"unionpay":         {"UnionPay", 16, []int{62}, 3},
+	"maestro":          {"Maestro", 16, []int{5018, 5020, 5038, 5893, 6304, 6759, 6761, 6762, 6763}, 3},
+	"mir":              {"Mir", 16, prefixRange(2200, 2204), 3},
 }
 
-var pay Render
+// creditCardNetworks are the keys of creditCards, sorted so that seeded generations are deterministic
+var creditCardNetworks = func() []string {
+	networks := make([]string, 0, len(creditCards))
+	for network := range creditCards {
+		networks = append(networks, network)
+	}
+	sort.Strings(networks)
+	return networks
+}()
+
+// prefixRange returns the prefixes from start to end included
+func prefixRange(start, end int) []int {
+	prefixes := make([]int, 0, end-start+1)
+	for prefix := start; prefix <= end; prefix++ {
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes
+}
+
+// ibanFormats are the formats of the basic bank account numbers of the countries using IBANs: n stands for digits,
+// a for capital letters and c for both, each preceded by their count | Source: https://www.swift.com/standards/data-standards/iban
+var ibanFormats = map[string]string{
+	"AT": "16n",
+	"BE": "12n",
+	"BR": "23n1a1c",
+	"CH": "5n12c",
+	"DE": "18n",
+	"DK": "14n",
+	"ES": "20n",
+	"FI": "14n",
+	"FR": "10n11c2n",
+	"GB": "4a14n",
+	"IE": "4a14n",
+	"IT": "1a10n12c",
+	"LU": "3n13c",
+	"NL": "4a10n",
+	"NO": "11n",
+	"PL": "24n",
+	"PT": "21n",
+	"SE": "20n",
+}
+
+// ibanCountries are the keys of ibanFormats, sorted so that seeded generations are deterministic
+var ibanCountries = func() []string {
+	countries := make([]string, 0, len(ibanFormats))
+	for country := range ibanFormats {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+	return countries
+}()
+
+const (
+	upperLetterBytes = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	alphanumBytes    = upperLetterBytes + numberBytes
+)
 
-var cacheCreditCard string
+// CreditCard is a payment card whose number, CVV and type belong to the same network
+type CreditCard struct {
+	Type        string
+	Number      string
+	Holder      string
+	ExpiryMonth int
+	ExpiryYear  int
+	// Expiry is the expiry date as printed on the card: MM/YY
+	Expiry string
+	CVV    string
+}
+
+var pay Render
 
 // GetPayment returns a new Render interface of Payment struct
 func GetPayment() Render {
@@ -44,6 +116,9 @@ func GetPayment() Render {
 
 // SetPayment set custom Network
 func SetPayment(p Render) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	pay = p
 }
 
@@ -54,58 +129,372 @@ type Render interface {
 }
 
 // Payment struct
-type Payment struct{}
+type Payment struct {
+	provider
+}
 
-func (p Payment) cctype() string {
-	n := len(creditCards)
-	if cacheCreditCard != "" {
-		return cacheCreditCard
-	}
-	var ccTypes []string
+// randomNetwork returns a random card network
+func (p Payment) randomNetwork() creditCard {
+	return creditCards[creditCardNetworks[p.rnd().Intn(len(creditCardNetworks))]]
+}
 
-	for _, cc := range creditCards {
-		ccTypes = append(ccTypes, cc.ccType)
+// network returns the card network set with the network tag parameter, otherwise the one of the card fields generated
+// before in the same struct, or a random one, so that the type, the number and the CVV of a struct match
+func (p Payment) network() (creditCard, error) {
+	var card creditCard
+	if network, ok := p.param(NetworkParam); ok {
+		if card, ok = creditCards[strings.Replace(strings.ToLower(network), "_", " ", -1)]; !ok {
+			return creditCard{}, fmt.Errorf(ErrUnknownCardNetwork, network)
+		}
+	} else if p.card != nil && p.card.ccType != "" {
+		return *p.card, nil
+	} else {
+		card = p.randomNetwork()
+	}
+	if p.card != nil {
+		*p.card = card
 	}
-	cacheCreditCard = ccTypes[rand.Intn(n)]
-	return cacheCreditCard
+	return card, nil
 }
 
 // CreditCardType returns one of the following credit values:
-// VISA, MasterCard, American Express, Discover, JCB and Diners Club
+// VISA, MasterCard, American Express, Discover, JCB, Diners Club, UnionPay, Maestro and Mir
 func (p Payment) CreditCardType(v reflect.Value) (interface{}, error) {
-	return p.cctype(), nil
+	card, err := p.network()
+	if err != nil {
+		return nil, err
+	}
+	return card.ccType, nil
 }
 
 // CCType get a credit card type randomly in string (VISA, MasterCard, etc)
 func CCType() string {
 	return singleFakeData(CreditCardType, func() interface{} {
 		p := Payment{}
-		return p.cctype()
+		return p.randomNetwork().ccType
 	}).(string)
 }
 
-func (p Payment) ccnumber() string {
-	ccType := p.cctype()
-	cacheCreditCard = ccType
-	card := creditCards[strings.ToLower(ccType)]
-	prefix := strconv.Itoa(card.prefixes[rand.Intn(len(card.prefixes))])
-
-	num := prefix
-	digit := randomStringNumber(card.length - len(prefix))
+// number returns a card number of the network, whose last digit is the Luhn check digit
+func (p Payment) number(card creditCard) string {
+	prefix := strconv.Itoa(card.prefixes[p.rnd().Intn(len(card.prefixes))])
+	num := prefix + randomStringNumber(p.rnd(), card.length-len(prefix)-1)
+	return num + strconv.Itoa(luhnCheckDigit(num))
+}
 
-	num += digit
-	return num
+// luhnCheckDigit returns the digit to append to num so that it passes the Luhn check
+func luhnCheckDigit(num string) int {
+	sum := 0
+	for i := len(num) - 1; i >= 0; i-- {
+		d := int(num[i] - '0')
+		if (len(num)-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
 }
 
-// CreditCardNumber generated credit card number according to the card number rules
+// CreditCardNumber generates a Luhn valid credit card number according to the card number rules of a random network,
+// or of the one set with the network tag parameter, e.g. `faker:"cc_number,network=visa"`
 func (p Payment) CreditCardNumber(v reflect.Value) (interface{}, error) {
-	return p.ccnumber(), nil
+	card, err := p.network()
+	if err != nil {
+		return nil, err
+	}
+	return p.number(card), nil
 }
 
 // CCNumber get a credit card number randomly in string (VISA, MasterCard, etc)
 func CCNumber() string {
 	return singleFakeData(CreditCardNumber, func() interface{} {
 		p := Payment{}
-		return p.ccnumber()
+		return p.number(p.randomNetwork())
+	}).(string)
+}
+
+// expiry returns the month and year of an expiry date from next month to 5 years from now
+func (p Payment) expiry() (int, int) {
+	t := p.currentTime().AddDate(0, 1+p.rnd().Intn(60), 0)
+	return int(t.Month()), t.Year()
+}
+
+func (p Payment) ccexpiry() string {
+	month, year := p.expiry()
+	return fmt.Sprintf("%02d/%02d", month, year%100)
+}
+
+// CreditCardExpiry returns an expiry date in the future, formatted as MM/YY
+func (p Payment) CreditCardExpiry(v reflect.Value) (interface{}, error) {
+	return p.ccexpiry(), nil
+}
+
+// CCExpiry get a credit card expiry date in the future (MM/YY)
+func CCExpiry() string {
+	return singleFakeData(CreditCardExpiry, func() interface{} {
+		p := Payment{}
+		return p.ccexpiry()
+	}).(string)
+}
+
+func (p Payment) cccvv(card creditCard) string {
+	return randomStringNumber(p.rnd(), card.cvvLength)
+}
+
+// CreditCardCVV returns a card verification value of the length used by a random network,
+// or by the one set with the network tag parameter: 4 digits for American Express, 3 otherwise
+func (p Payment) CreditCardCVV(v reflect.Value) (interface{}, error) {
+	card, err := p.network()
+	if err != nil {
+		return nil, err
+	}
+	return p.cccvv(card), nil
+}
+
+// CCCVV get a credit card verification value
+func CCCVV() string {
+	return singleFakeData(CreditCardCVV, func() interface{} {
+		p := Payment{}
+		return p.cccvv(p.randomNetwork())
+	}).(string)
+}
+
+func (p Payment) ccholder() string {
+	person := Person{p.provider}
+	return strings.ToUpper(person.firstname() + " " + person.lastname())
+}
+
+// CreditCardHolder returns the name of a cardholder, in capital letters as printed on the card
+func (p Payment) CreditCardHolder(v reflect.Value) (interface{}, error) {
+	return p.ccholder(), nil
+}
+
+// CCHolder get a credit card holder name
+func CCHolder() string {
+	return singleFakeData(CreditCardHolder, func() interface{} {
+		p := Payment{}
+		return p.ccholder()
+	}).(string)
+}
+
+func (p Payment) creditCard(card creditCard) CreditCard {
+	month, year := p.expiry()
+	return CreditCard{
+		Type:        card.ccType,
+		Number:      p.number(card),
+		Holder:      p.ccholder(),
+		ExpiryMonth: month,
+		ExpiryYear:  year,
+		Expiry:      fmt.Sprintf("%02d/%02d", month, year%100),
+		CVV:         p.cccvv(card),
+	}
+}
+
+// CreditCard returns a CreditCard of a random network, or of the one set with the network tag parameter
+func (p Payment) CreditCard(v reflect.Value) (interface{}, error) {
+	card, err := p.network()
+	if err != nil {
+		return nil, err
+	}
+	return p.creditCard(card), nil
+}
+
+// FakeCreditCard get fake CreditCard
+func FakeCreditCard() CreditCard {
+	return singleFakeData(CreditCardTag, func() interface{} {
+		p := Payment{}
+		return p.creditCard(p.randomNetwork())
+	}).(CreditCard)
+}
+
+// randomBytesFrom returns n characters drawn from chars
+func (p Payment) randomBytesFrom(chars string, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = chars[p.rnd().Intn(len(chars))]
+	}
+	return string(b)
+}
+
+// defaultIBANCountry returns the country of the locale when it uses IBANs, a random one otherwise
+func (p Payment) defaultIBANCountry() string {
+	if country := p.locale().country.alpha2; ibanFormats[country] != "" {
+		return country
+	}
+	return ibanCountries[p.rnd().Intn(len(ibanCountries))]
+}
+
+// ibanCountry returns the country set with the country tag parameter, the default one otherwise
+func (p Payment) ibanCountry() (string, error) {
+	if country, ok := p.param(CountryParam); ok {
+		country = strings.ToUpper(country)
+		if _, ok := ibanFormats[country]; !ok {
+			return "", fmt.Errorf(ErrUnsupportedCountry, country)
+		}
+		return country, nil
+	}
+	return p.defaultIBANCountry(), nil
+}
+
+func (p Payment) iban(country string) string {
+	bban := ""
+	format := ibanFormats[country]
+	for len(format) > 0 {
+		i := strings.IndexAny(format, "nac")
+		count, _ := strconv.Atoi(format[:i])
+		switch format[i] {
+		case 'n':
+			bban += p.randomBytesFrom(numberBytes, count)
+		case 'a':
+			bban += p.randomBytesFrom(upperLetterBytes, count)
+		default:
+			bban += p.randomBytesFrom(alphanumBytes, count)
+		}
+		format = format[i+1:]
+	}
+	return fmt.Sprintf("%s%02d%s", country, 98-mod97(bban+country+"00"), bban)
+}
+
+// mod97 returns the remainder of the division by 97 of s, whose letters stand for 10 to 35, as in ISO 7064
+func mod97(s string) int {
+	rem := 0
+	for _, c := range s {
+		if c >= 'A' && c <= 'Z' {
+			rem = (rem*100 + int(c-'A') + 10) % 97
+		} else {
+			rem = (rem*10 + int(c-'0')) % 97
+		}
+	}
+	return rem
+}
+
+// IBAN returns an IBAN with valid check digits, of the country set with the country tag parameter,
+// e.g. `faker:"iban,country=DE"`, of the country of the locale or of a random country
+func (p Payment) IBAN(v reflect.Value) (interface{}, error) {
+	country, err := p.ibanCountry()
+	if err != nil {
+		return nil, err
+	}
+	return p.iban(country), nil
+}
+
+// IBAN get fake IBAN
+func IBAN() string {
+	return singleFakeData(IBANTag, func() interface{} {
+		p := Payment{}
+		return p.iban(p.defaultIBANCountry())
+	}).(string)
+}
+
+// bicCountry returns the country set with the country tag parameter, the one of the locale otherwise
+func (p Payment) bicCountry() (string, error) {
+	country, ok := p.param(CountryParam)
+	if !ok {
+		return p.locale().country.alpha2, nil
+	}
+	country = strings.ToUpper(country)
+	if !knownCountry(country) {
+		return "", fmt.Errorf(ErrUnknownCountry, country)
+	}
+	return country, nil
+}
+
+func (p Payment) bic(country string) string {
+	// The second character of the location code is not 0, which is used by test BICs
+	bic := p.randomBytesFrom(upperLetterBytes, 4) + country + p.randomBytesFrom(alphanumBytes, 1) + p.randomBytesFrom(upperLetterBytes+numberBytes[1:], 1)
+	switch p.rnd().Intn(3) {
+	case 0:
+		return bic
+	case 1:
+		return bic + "XXX"
+	}
+	return bic + p.randomBytesFrom(alphanumBytes, 3)
+}
+
+// knownCountry tells if alpha2 is the code of a country of countries
+func knownCountry(alpha2 string) bool {
+	for _, c := range countries {
+		if c.alpha2 == alpha2 {
+			return true
+		}
+	}
+	return false
+}
+
+// BIC returns a BIC, or SWIFT code, of 8 or 11 characters, of the country set with the country tag parameter
+// or of the country of the locale
+func (p Payment) BIC(v reflect.Value) (interface{}, error) {
+	country, err := p.bicCountry()
+	if err != nil {
+		return nil, err
+	}
+	return p.bic(country), nil
+}
+
+// BIC get fake BIC
+func BIC() string {
+	return singleFakeData(BICTag, func() interface{} {
+		p := Payment{}
+		return p.bic(p.locale().country.alpha2)
+	}).(string)
+}
+
+// abaPrefixes are the first two digits of the routing numbers: the Federal Reserve districts, their thrift
+// institutions and electronic transactions
+var abaPrefixes = append(append(prefixRange(1, 12), prefixRange(21, 32)...), append(prefixRange(61, 72), 80)...)
+
+func (p Payment) abaRoutingNumber() string {
+	num := fmt.Sprintf("%02d", abaPrefixes[p.rnd().Intn(len(abaPrefixes))]) + randomStringNumber(p.rnd(), 6)
+	sum := 0
+	for i, weight := range []int{3, 7, 1, 3, 7, 1, 3, 7} {
+		sum += weight * int(num[i]-'0')
+	}
+	return num + strconv.Itoa((10-sum%10)%10)
+}
+
+// ABARoutingNumber returns a US ABA routing number with a valid check digit
+func (p Payment) ABARoutingNumber(v reflect.Value) (interface{}, error) {
+	return p.abaRoutingNumber(), nil
+}
+
+// ABARoutingNumber get fake ABA routing number
+func ABARoutingNumber() string {
+	return singleFakeData(ABARoutingNumberTag, func() interface{} {
+		p := Payment{}
+		return p.abaRoutingNumber()
+	}).(string)
+}
+
+func (p Payment) ukSortCode() string {
+	code := randomStringNumber(p.rnd(), 6)
+	return code[:2] + "-" + code[2:4] + "-" + code[4:]
+}
+
+// UKSortCode returns a UK bank sort code of type: "20-00-00"
+func (p Payment) UKSortCode(v reflect.Value) (interface{}, error) {
+	return p.ukSortCode(), nil
+}
+
+// UKSortCode get fake UK sort code
+func UKSortCode() string {
+	return singleFakeData(UKSortCodeTag, func() interface{} {
+		p := Payment{}
+		return p.ukSortCode()
+	}).(string)
+}
+
+// UKAccountNumber returns a UK bank account number of 8 digits
+func (p Payment) UKAccountNumber(v reflect.Value) (interface{}, error) {
+	return randomStringNumber(p.rnd(), 8), nil
+}
+
+// UKAccountNumber get fake UK account number
+func UKAccountNumber() string {
+	return singleFakeData(UKAccountNumberTag, func() interface{} {
+		p := Payment{}
+		return randomStringNumber(p.rnd(), 8)
 	}).(string)
 }
@@ -1,9 +1,12 @@
 package faker
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestCreditCardType(t *testing.T) {
@@ -45,3 +48,247 @@ func TestFakeCreditCardNumber(t *testing.T) {
 		t.Error("Expected Credit Card Number ")
 	}
 }
+
+// luhnValid tells if num passes the Luhn check
+func luhnValid(num string) bool {
+	sum := 0
+	for i := len(num) - 1; i >= 0; i-- {
+		d := int(num[i] - '0')
+		if (len(num)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// hasPrefix tells if num starts with one of the prefixes of card
+func hasPrefix(card creditCard, num string) bool {
+	for _, prefix := range card.prefixes {
+		if strings.HasPrefix(num, strconv.Itoa(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCreditCardNumberNetworks(t *testing.T) {
+	for network, card := range creditCards {
+		p := Payment{provider{params: map[string]string{NetworkParam: network}}}
+		for i := 0; i < 50; i++ {
+			num, err := p.CreditCardNumber(reflect.Value{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := num.(string); len(s) != card.length || !hasPrefix(card, s) || !luhnValid(s) {
+				t.Errorf("%s: expected a Luhn valid number of the network, but got %s", network, s)
+			}
+			cvv, err := p.CreditCardCVV(reflect.Value{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(cvv.(string)) != card.cvvLength {
+				t.Errorf("%s: expected a CVV of %d digits, but got %s", network, card.cvvLength, cvv)
+			}
+		}
+	}
+	if !luhnValid(CCNumber()) {
+		t.Error("expected a Luhn valid number")
+	}
+}
+
+func TestCreditCard(t *testing.T) {
+	var r struct {
+		Card    CreditCard  `faker:"credit_card"`
+		Amex    *CreditCard `faker:"credit_card,network=american_express"`
+		Number  string      `faker:"cc_number,network=Mir"`
+		Expiry  string      `faker:"cc_expiry"`
+		CVV     string      `faker:"cc_cvv,network=visa"`
+		Holder  string      `faker:"cc_holder"`
+		Network string      `faker:"cc_type,network=unionpay"`
+	}
+	now := time.Now()
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		c := r.Card
+		card := creditCards[strings.ToLower(c.Type)]
+		if !hasPrefix(card, c.Number) || len(c.Number) != card.length || !luhnValid(c.Number) || len(c.CVV) != card.cvvLength {
+			t.Errorf("expected a consistent card, but got %+v", c)
+		}
+		if c.ExpiryYear < now.Year() || c.ExpiryYear == now.Year() && c.ExpiryMonth <= int(now.Month()) ||
+			c.Expiry != fmt.Sprintf("%02d/%02d", c.ExpiryMonth, c.ExpiryYear%100) {
+			t.Errorf("expected an expiry date in the future, but got %+v", c)
+		}
+		if c.Holder == "" || c.Holder != strings.ToUpper(c.Holder) || !strings.Contains(c.Holder, " ") {
+			t.Errorf("expected a holder name, but got %s", c.Holder)
+		}
+		if r.Amex == nil || r.Amex.Type != "American Express" || len(r.Amex.CVV) != 4 || !luhnValid(r.Amex.Number) {
+			t.Errorf("expected an American Express card, but got %+v", r.Amex)
+		}
+		if !strings.HasPrefix(r.Number, "220") || !luhnValid(r.Number) {
+			t.Errorf("expected a Mir number, but got %s", r.Number)
+		}
+		if len(r.Expiry) != 5 || r.Expiry[2] != '/' || len(r.CVV) != 3 || r.Network != "UnionPay" {
+			t.Errorf("expected card parts, but got %s, %s and %s", r.Expiry, r.CVV, r.Network)
+		}
+	}
+	if c := FakeCreditCard(); !luhnValid(c.Number) {
+		t.Errorf("expected a Luhn valid card, but got %+v", c)
+	}
+	for _, fn := range []func() string{CCExpiry, CCCVV, CCHolder} {
+		if fn() == "" {
+			t.Error("expected a value")
+		}
+	}
+}
+
+func TestCreditCardFieldsShareNetwork(t *testing.T) {
+	type Card struct {
+		Type   string `faker:"cc_type"`
+		Number string `faker:"cc_number"`
+		CVV    string `faker:"cc_cvv"`
+	}
+	var r struct {
+		Cards   []Card
+		Type    string `faker:"cc_type,network=american_express"`
+		Number  string `faker:"cc_number"`
+		Default string `faker:"cc_number"`
+	}
+	types := map[string]bool{}
+	for i := 0; i < 20; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range r.Cards {
+			card := creditCards[strings.ToLower(c.Type)]
+			if !hasPrefix(card, c.Number) || len(c.Number) != card.length || len(c.CVV) != card.cvvLength {
+				t.Errorf("expected the number and the CVV of the type, but got %+v", c)
+			}
+			types[c.Type] = true
+		}
+		if amex := creditCards["american express"]; !hasPrefix(amex, r.Number) || !hasPrefix(amex, r.Default) {
+			t.Errorf("expected the numbers of the network of the type, but got %s and %s", r.Number, r.Default)
+		}
+	}
+	if len(types) < 2 {
+		t.Errorf("expected the cards of the slice to have their own networks, but got %v", types)
+	}
+
+	type Account struct {
+		Type string `faker:"cc_type,unique"`
+	}
+	defer ResetUnique()
+	for range creditCards {
+		if err := FakeData(&Account{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCreditCardUnknownNetwork(t *testing.T) {
+	var r struct {
+		Number string `faker:"cc_number,network=unknown"`
+	}
+	if err := FakeData(&r); err == nil || err.Error() != fmt.Sprintf(ErrUnknownCardNetwork, "unknown") {
+		t.Errorf("expected an unknown card network error, but got %v", err)
+	}
+}
+
+func TestCreditCardSeed(t *testing.T) {
+	var a, b struct {
+		Card CreditCard `faker:"credit_card"`
+	}
+	if err := FakeData(&a, Seed(7)); err != nil {
+		t.Fatal(err)
+	}
+	if err := FakeData(&b, Seed(7)); err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Errorf("expected the same card from the same seed, but got %+v and %+v", a, b)
+	}
+}
+
+func TestIBAN(t *testing.T) {
+	lengths := map[string]int{"DE": 22, "GB": 22, "FR": 27, "ES": 24, "IT": 27, "NL": 18, "BE": 16, "CH": 21, "BR": 29, "NO": 15}
+	for country, length := range lengths {
+		p := Payment{provider{params: map[string]string{CountryParam: strings.ToLower(country)}}}
+		for i := 0; i < 20; i++ {
+			iban, err := p.IBAN(reflect.Value{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := iban.(string)
+			if len(s) != length || !strings.HasPrefix(s, country) || mod97(s[4:]+s[:4]) != 1 {
+				t.Errorf("%s: expected a valid IBAN of %d characters, but got %s", country, length, s)
+			}
+		}
+	}
+	// Known valid IBAN
+	if s := "GB82WEST12345698765432"; mod97(s[4:]+s[:4]) != 1 {
+		t.Error("expected mod97 to validate a known IBAN")
+	}
+
+	var r struct {
+		IBAN string `faker:"iban"`
+	}
+	if err := FakeData(&r, WithLocale("fr_FR")); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(r.IBAN, "FR") {
+		t.Errorf("expected a French IBAN, but got %s", r.IBAN)
+	}
+	if s := IBAN(); mod97(s[4:]+s[:4]) != 1 {
+		t.Errorf("expected a valid IBAN, but got %s", s)
+	}
+
+	var wrong struct {
+		IBAN string `faker:"iban,country=US"`
+	}
+	if err := FakeData(&wrong); err == nil || err.Error() != fmt.Sprintf(ErrUnsupportedCountry, "US") {
+		t.Errorf("expected an unsupported country error, but got %v", err)
+	}
+}
+
+func TestBankCodes(t *testing.T) {
+	var r struct {
+		BIC           string `faker:"bic,country=DE"`
+		Routing       string `faker:"aba_routing_number"`
+		SortCode      string `faker:"uk_sort_code"`
+		AccountNumber string `faker:"uk_account_number"`
+	}
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		if len(r.BIC) != 8 && len(r.BIC) != 11 || r.BIC[4:6] != "DE" || r.BIC[7] == '0' || strings.Trim(r.BIC[:4], upperLetterBytes) != "" {
+			t.Errorf("expected a German BIC, but got %s", r.BIC)
+		}
+		d := func(i int) int { return int(r.Routing[i] - '0') }
+		if len(r.Routing) != 9 || (3*(d(0)+d(3)+d(6))+7*(d(1)+d(4)+d(7))+d(2)+d(5)+d(8))%10 != 0 {
+			t.Errorf("expected a valid routing number, but got %s", r.Routing)
+		}
+		if len(r.SortCode) != 8 || r.SortCode[2] != '-' || r.SortCode[5] != '-' {
+			t.Errorf("expected a sort code, but got %s", r.SortCode)
+		}
+		if len(r.AccountNumber) != 8 || strings.Trim(r.AccountNumber, numberBytes) != "" {
+			t.Errorf("expected an account number, but got %s", r.AccountNumber)
+		}
+	}
+	for _, fn := range []func() string{BIC, ABARoutingNumber, UKSortCode, UKAccountNumber} {
+		if fn() == "" {
+			t.Error("expected a value")
+		}
+	}
+	var wrong struct {
+		BIC string `faker:"bic,country=XX"`
+	}
+	if err := FakeData(&wrong); err == nil || err.Error() != fmt.Sprintf(ErrUnknownCountry, "XX") {
+		t.Errorf("expected an unknown country error, but got %v", err)
+	}
+}
@@ -1,9 +1,12 @@
 package faker
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"strconv"
+	"time"
 )
 
 // Dowser provides interfaces to generate random logical Names with their initials
@@ -121,15 +124,19 @@ func GetPerson() Dowser {
 
 // SetDowser sets custom Dowsers of Person names
 func SetDowser(d Dowser) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	person = d
 }
 
 // Person struct
 type Person struct {
+	provider
 }
 
 func (p Person) titlemale() string {
-	return randomElementFromSliceString(titlesMale)
+	return randomElementFromSliceString(p.rnd(), p.dataset(titlesMaleDataset))
 }
 
 // TitleMale generates random titles for males
@@ -146,7 +153,7 @@ func TitleMale() string {
 }
 
 func (p Person) titleFemale() string {
-	return randomElementFromSliceString(titlesFemale)
+	return randomElementFromSliceString(p.rnd(), p.dataset(titlesFemaleDataset))
 }
 
 // TitleFeMale generates random titles for females
@@ -163,7 +170,7 @@ func TitleFemale() string {
 }
 
 func (p Person) firstname() string {
-	return randomElementFromSliceString(firstNames)
+	return randomElementFromSliceString(p.rnd(), p.dataset(firstNamesDataset))
 }
 
 // FirstName returns first names
@@ -180,7 +187,7 @@ func FirstName() string {
 }
 
 func (p Person) firstnamemale() string {
-	return randomElementFromSliceString(firstNamesMale)
+	return randomElementFromSliceString(p.rnd(), p.dataset(firstNamesMaleDataset))
 }
 
 // FirstNameMale returns first names for males
@@ -197,7 +204,7 @@ func FirstNameMale() string {
 }
 
 func (p Person) firstnamefemale() string {
-	return randomElementFromSliceString(firstNamesFemale)
+	return randomElementFromSliceString(p.rnd(), p.dataset(firstNamesFemaleDataset))
 }
 
 // FirstNameFemale returns first names for females
@@ -214,7 +221,7 @@ func FirstNameFemale() string {
 }
 
 func (p Person) lastname() string {
-	return randomElementFromSliceString(lastNames)
+	return randomElementFromSliceString(p.rnd(), p.dataset(lastNamesDataset))
 }
 
 // LastName returns last name
@@ -231,10 +238,16 @@ func LastName() string {
 }
 
 func (p Person) name() string {
+	if p.locale().familyNameFirst {
+		if randNameFlag > 50 {
+			return fmt.Sprintf("%s %s", p.lastname(), p.firstnamefemale())
+		}
+		return fmt.Sprintf("%s %s", p.lastname(), p.firstnamemale())
+	}
 	if randNameFlag > 50 {
-		return fmt.Sprintf("%s %s %s", randomElementFromSliceString(titlesFemale), randomElementFromSliceString(firstNamesFemale), randomElementFromSliceString(lastNames))
+		return fmt.Sprintf("%s %s %s", randomElementFromSliceString(p.rnd(), p.dataset(titlesFemaleDataset)), randomElementFromSliceString(p.rnd(), p.dataset(firstNamesFemaleDataset)), randomElementFromSliceString(p.rnd(), p.dataset(lastNamesDataset)))
 	}
-	return fmt.Sprintf("%s %s %s", randomElementFromSliceString(titlesMale), randomElementFromSliceString(firstNamesMale), randomElementFromSliceString(lastNames))
+	return fmt.Sprintf("%s %s %s", randomElementFromSliceString(p.rnd(), p.dataset(titlesMaleDataset)), randomElementFromSliceString(p.rnd(), p.dataset(firstNamesMaleDataset)), randomElementFromSliceString(p.rnd(), p.dataset(lastNamesDataset)))
 }
 
 // Name returns a random name
@@ -249,3 +262,116 @@ func Name() string {
 		return p.name()
 	}).(string)
 }
+
+// Default ages of the birthdates and the ages, adults
+const (
+	defaultMinAge = 18
+	defaultMaxAge = 80
+)
+
+// ageRange returns the ages set with the min_age and max_age tag parameters, 18 and 80 by default.
+// A single one of them moves the other when they would cross, e.g. max_age=12 alone gives ages from 0 to 12.
+func (p Person) ageRange() (int, int, error) {
+	minAge, maxAge := defaultMinAge, defaultMaxAge
+	minValue, hasMin := p.param(MinAgeParam)
+	maxValue, hasMax := p.param(MaxAgeParam)
+	var err error
+	if hasMin {
+		if minAge, err = strconv.Atoi(minValue); err != nil || minAge < 0 || minAge > 150 {
+			return 0, 0, fmt.Errorf(ErrWrongFormattedTag, MinAgeParam+Equals+minValue)
+		}
+	}
+	if hasMax {
+		if maxAge, err = strconv.Atoi(maxValue); err != nil || maxAge < 0 || maxAge > 150 {
+			return 0, 0, fmt.Errorf(ErrWrongFormattedTag, MaxAgeParam+Equals+maxValue)
+		}
+	}
+	switch {
+	case minAge <= maxAge:
+	case hasMin && !hasMax:
+		maxAge = minAge
+	case hasMax && !hasMin:
+		minAge = 0
+	default:
+		return 0, 0, errors.New(ErrStartValueBiggerThanEnd)
+	}
+	return minAge, maxAge, nil
+}
+
+// yearsBetween returns the age at now of a person born at birth
+func yearsBetween(birth, now time.Time) int {
+	years := now.Year() - birth.Year()
+	if now.Month() < birth.Month() || now.Month() == birth.Month() && now.Day() < birth.Day() {
+		years--
+	}
+	return years
+}
+
+// birthdate returns the date of birth, at midnight, of a person whose age is between minAge and maxAge
+// at the current time of the generation, see SetClock
+func (p Person) birthdate(minAge, maxAge int) time.Time {
+	now := p.currentTime()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	// AddDate moves the 29th of February to the 1st of March, the days are then adjusted to the exact ages
+	latest := today.AddDate(-minAge, 0, 0)
+	for yearsBetween(latest, today) < minAge {
+		latest = latest.AddDate(0, 0, -1)
+	}
+	earliest := today.AddDate(-maxAge-1, 0, 0)
+	for yearsBetween(earliest, today) > maxAge {
+		earliest = earliest.AddDate(0, 0, 1)
+	}
+	days := int(latest.Sub(earliest).Hours()/24 + 0.5)
+	return earliest.AddDate(0, 0, p.rnd().Intn(days+1))
+}
+
+// Birthdate returns the date of birth of a person whose age is within the min_age and max_age tag parameters,
+// 18 and 80 by default, e.g. `faker:"birthdate,min_age=18,max_age=65"`. String fields get it formatted as 2006-01-02.
+func (p Person) Birthdate(v reflect.Value) (interface{}, error) {
+	minAge, maxAge, err := p.ageRange()
+	if err != nil {
+		return nil, err
+	}
+	t := p.birthdate(minAge, maxAge)
+	if v.IsValid() && v.Type() == timeType {
+		return t, nil
+	}
+	return t.Format(BaseDateFormat), nil
+}
+
+// Birthdate get fake date of birth of an adult
+func Birthdate() time.Time {
+	return singleFakeData(BirthdateTag, func() interface{} {
+		p := Person{}
+		return p.birthdate(defaultMinAge, defaultMaxAge)
+	}).(time.Time)
+}
+
+func (p Person) age(minAge, maxAge int) int {
+	return minAge + p.rnd().Intn(maxAge-minAge+1)
+}
+
+// Age returns an age within the min_age and max_age tag parameters, 18 and 80 by default, e.g.
+// `faker:"age,min_age=18,max_age=65"`. `faker:"age,from=Birthdate"` gives the age of the date of birth of a sibling field.
+func (p Person) Age(v reflect.Value) (interface{}, error) {
+	minAge, maxAge, err := p.ageRange()
+	if err != nil {
+		return nil, err
+	}
+	age := p.age(minAge, maxAge)
+	switch {
+	case !v.IsValid() || v.Kind() == reflect.String:
+		return strconv.Itoa(age), nil
+	case isNumber(v.Kind()):
+		return reflect.ValueOf(age).Convert(v.Type()).Interface(), nil
+	}
+	return nil, errors.New(ErrNotSupportedTypeForTag)
+}
+
+// Age get fake age of an adult
+func Age() int {
+	return singleFakeData(AgeTag, func() interface{} {
+		p := Person{}
+		return p.age(defaultMinAge, defaultMaxAge)
+	}).(int)
+}
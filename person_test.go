@@ -1,8 +1,10 @@
 package faker
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/togglhire/faker/v3/support/slice"
 )
@@ -148,3 +150,109 @@ func TestFakeNameFemale(t *testing.T) {
 		t.Error("Expected from function name string get empty string")
 	}
 }
+
+func TestYearsBetween(t *testing.T) {
+	tests := []struct {
+		birth, now string
+		years      int
+	}{
+		{"2000-06-15", "2024-06-14", 23},
+		{"2000-06-15", "2024-06-15", 24},
+		{"2000-02-29", "2023-02-28", 22},
+		{"2000-02-29", "2023-03-01", 23},
+		{"2000-02-29", "2024-02-29", 24},
+	}
+	for _, test := range tests {
+		birth, _ := time.Parse(BaseDateFormat, test.birth)
+		now, _ := time.Parse(BaseDateFormat, test.now)
+		if years := yearsBetween(birth, now); years != test.years {
+			t.Errorf("expected %d years between %s and %s, but got %d", test.years, test.birth, test.now, years)
+		}
+	}
+}
+
+func TestBirthdate(t *testing.T) {
+	// the 29th of February is the edge case of the ages
+	for _, now := range []time.Time{
+		time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+		time.Date(2023, 12, 31, 23, 59, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		clock := func() time.Time { return now }
+		ages := map[int]bool{}
+		for i := 0; i < 500; i++ {
+			var r struct {
+				Birthdate time.Time  `faker:"birthdate,min_age=18,max_age=20"`
+				Pointer   *time.Time `faker:"birthdate,min_age=18,max_age=20"`
+				String    string     `faker:"birthdate,min_age=18,max_age=20"`
+				Age       int        `faker:"age,from=Birthdate"`
+				Minor     time.Time  `faker:"birthdate,max_age=12"`
+				Retired   time.Time  `faker:"birthdate,min_age=99"`
+			}
+			if err := FakeData(&r, WithClock(clock)); err != nil {
+				t.Fatal(err)
+			}
+			ages[r.Age] = true
+			if r.Age < 18 || r.Age > 20 || yearsBetween(r.Birthdate, now) != r.Age {
+				t.Errorf("%v: expected an age between 18 and 20, but got %d for %v", now, r.Age, r.Birthdate)
+			}
+			if r.Pointer == nil || yearsBetween(*r.Pointer, now) < 18 || yearsBetween(*r.Pointer, now) > 20 {
+				t.Errorf("%v: expected a birthdate of an age between 18 and 20, but got %v", now, r.Pointer)
+			}
+			birth, err := time.Parse(BaseDateFormat, r.String)
+			if err != nil || yearsBetween(birth, now) < 18 || yearsBetween(birth, now) > 20 {
+				t.Errorf("%v: expected a birthdate of an age between 18 and 20, but got %s", now, r.String)
+			}
+			if age := yearsBetween(r.Minor, now); age > 12 {
+				t.Errorf("%v: expected an age up to 12, but got %d", now, age)
+			}
+			if age := yearsBetween(r.Retired, now); age != 99 {
+				t.Errorf("%v: expected an age of 99, but got %d", now, age)
+			}
+		}
+		if len(ages) != 3 {
+			t.Errorf("%v: expected ages of 18, 19 and 20, but got %v", now, ages)
+		}
+	}
+	if age := yearsBetween(Birthdate(), time.Now()); age < defaultMinAge || age > defaultMaxAge {
+		t.Errorf("expected the birthdate of an adult, but got an age of %d", age)
+	}
+}
+
+func TestAge(t *testing.T) {
+	var r struct {
+		Age    int    `faker:"age,min_age=30,max_age=40"`
+		Uint8  uint8  `faker:"age"`
+		String string `faker:"age,min_age=5,max_age=5"`
+	}
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		if r.Age < 30 || r.Age > 40 {
+			t.Errorf("expected an age between 30 and 40, but got %d", r.Age)
+		}
+		if r.Uint8 < defaultMinAge || r.Uint8 > defaultMaxAge {
+			t.Errorf("expected an adult age, but got %d", r.Uint8)
+		}
+		if r.String != "5" {
+			t.Errorf("expected 5, but got %s", r.String)
+		}
+	}
+	if age := Age(); age < defaultMinAge || age > defaultMaxAge {
+		t.Errorf("expected an adult age, but got %d", age)
+	}
+
+	var wrong struct {
+		Age int `faker:"age,min_age=40,max_age=30"`
+	}
+	if err := FakeData(&wrong); err == nil || err.Error() != ErrStartValueBiggerThanEnd {
+		t.Errorf("expected an error for crossed ages, but got %v", err)
+	}
+	var negative struct {
+		Age int `faker:"age,min_age=-1"`
+	}
+	if err := FakeData(&negative); err == nil || err.Error() != fmt.Sprintf(ErrWrongFormattedTag, "min_age=-1") {
+		t.Errorf("expected a wrong tag error, but got %v", err)
+	}
+}
@@ -2,11 +2,8 @@ package faker
 
 import (
 	"fmt"
-	"math/rand"
 	"reflect"
 	"strings"
-
-	"github.com/togglhire/faker/v3/support/slice"
 )
 
 var phone Phoner
@@ -24,6 +21,9 @@ func GetPhoner() Phoner {
 
 // SetPhoner sets custom Phoner
 func SetPhoner(p Phoner) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	phone = p
 }
 
@@ -36,74 +36,194 @@ type Phoner interface {
 
 // Phone struct
 type Phone struct {
+	provider
+}
+
+// phonePlan is the numbering plan of a country: its calling code, the trunk prefix dialed before national numbers
+// and the national formats of its numbers, in which # stands for a random digit and % for a random non zero digit
+type phonePlan struct {
+	country  string
+	code     string
+	trunk    string
+	landline []string
+	mobile   []string
+	tollFree []string
+}
+
+var usTollFree = []string{"(800) %##-####", "(833) %##-####", "(844) %##-####", "(855) %##-####", "(866) %##-####", "(877) %##-####", "(888) %##-####"}
+
+// Numbering plans | Source: https://en.wikipedia.org/wiki/List_of_telephone_numbering_plans
+var phonePlans = []phonePlan{
+	{"AU", "61", "0", []string{"(02) #### ####", "(03) #### ####", "(07) #### ####", "(08) #### ####"}, []string{"04## ### ###"}, []string{"1800 ### ###"}},
+	{"BR", "55", "0", []string{"(11) %###-####", "(21) %###-####", "(31) %###-####", "(61) %###-####"}, []string{"(11) 9####-####", "(21) 9####-####", "(31) 9####-####"}, []string{"0800 ### ####"}},
+	{"CA", "1", "", []string{"416-%##-####", "514-%##-####", "604-%##-####", "613-%##-####", "403-%##-####"}, []string{"416-%##-####", "514-%##-####", "604-%##-####", "613-%##-####", "403-%##-####"}, usTollFree},
+	{"CN", "86", "0", []string{"010 %#######", "021 %#######", "020 %#######"}, []string{"13# #### ####", "15# #### ####", "18# #### ####"}, []string{"400 ### ####"}},
+	{"DE", "49", "0", []string{"030 ########", "040 #######", "069 ########", "089 ########", "0221 #######"}, []string{"0151 ########", "0160 #######", "0170 #######", "0176 ########"}, []string{"0800 #######"}},
+	{"ES", "34", "", []string{"91# ## ## ##", "93# ## ## ##", "95# ## ## ##", "96# ## ## ##"}, []string{"6## ## ## ##", "7%# ## ## ##"}, []string{"800 ### ###", "900 ### ###"}},
+	{"FR", "33", "0", []string{"01 ## ## ## ##", "02 ## ## ## ##", "03 ## ## ## ##", "04 ## ## ## ##", "05 ## ## ## ##"}, []string{"06 ## ## ## ##", "07 ## ## ## ##"}, []string{"0800 ## ## ##"}},
+	{"GB", "44", "0", []string{"020 #### ####", "0117 ### ####", "0121 ### ####", "0131 ### ####", "0161 ### ####"}, []string{"07%## ######"}, []string{"0800 ### ####", "0808 ### ####"}},
+	{"IN", "91", "0", []string{"011 %#######", "022 %#######", "080 %#######"}, []string{"7#### #####", "8#### #####", "9#### #####"}, []string{"1800 ### ####"}},
+	{"IT", "39", "", []string{"02 ########", "06 ########", "011 #######", "055 #######"}, []string{"3%# ### ####"}, []string{"800 ######"}},
+	{"JP", "81", "0", []string{"03-####-####", "06-####-####", "045-###-####", "052-###-####"}, []string{"070-####-####", "080-####-####", "090-####-####"}, []string{"0120-###-###", "0800-###-####"}},
+	{"MX", "52", "", []string{"55 #### ####", "33 #### ####", "81 #### ####"}, []string{"55 #### ####", "33 #### ####", "81 #### ####"}, []string{"800 ### ####"}},
+	{"NL", "31", "0", []string{"010 ### ####", "020 ### ####", "070 ### ####"}, []string{"06 ########"}, []string{"0800 ####"}},
+	{"PT", "351", "", []string{"21# ### ###", "22# ### ###"}, []string{"91# ### ###", "93# ### ###", "96# ### ###"}, []string{"800 ### ###"}},
+	{"US", "1", "", []string{"%##-%##-####"}, []string{"%##-%##-####"}, usTollFree},
+}
+
+// findPhonePlan returns the numbering plan of the country with the given ISO 3166-1 alpha-2 code
+func findPhonePlan(country string) (phonePlan, bool) {
+	for _, plan := range phonePlans {
+		if strings.EqualFold(plan.country, country) {
+			return plan, true
+		}
+	}
+	return phonePlan{}, false
+}
+
+// localePlan returns the numbering plan of the country of the locale, or the one of the US when there is none
+func (p Phone) localePlan() phonePlan {
+	if plan, ok := findPhonePlan(p.locale().country.alpha2); ok {
+		return plan
+	}
+	plan, _ := findPhonePlan("US")
+	return plan
+}
+
+// plan returns the numbering plan of the country set with the country tag parameter, "random" for a random one,
+// or the one of the country of the locale
+func (p Phone) plan() (phonePlan, error) {
+	country, ok := p.param(CountryParam)
+	switch {
+	case !ok:
+		return p.localePlan(), nil
+	case country == "random":
+		return phonePlans[p.rnd().Intn(len(phonePlans))], nil
+	}
+	if plan, ok := findPhonePlan(country); ok {
+		return plan, nil
+	}
+	return phonePlan{}, fmt.Errorf(ErrUnknownCountry, country)
+}
+
+// phoneParams returns the numbering plan, see plan, and the line set with the line tag parameter
+func (p Phone) phoneParams() (phonePlan, string, error) {
+	plan, err := p.plan()
+	if err != nil {
+		return phonePlan{}, "", err
+	}
+	switch line, _ := p.param(LineParam); line {
+	case "", "landline", "mobile":
+		return plan, line, nil
+	default:
+		return phonePlan{}, "", fmt.Errorf(ErrWrongFormattedTag, LineParam+Equals+line)
+	}
+}
+
+// national returns a national number of the plan, landline or mobile depending on line, either of them when it is empty
+func (p Phone) national(plan phonePlan, line string) string {
+	formats := append(append([]string{}, plan.landline...), plan.mobile...)
+	switch line {
+	case "landline":
+		formats = plan.landline
+	case "mobile":
+		formats = plan.mobile
+	}
+	return p.digits(randomElementFromSliceString(p.rnd(), formats))
+}
+
+// e164 returns the national number in the E.164 format, its digits without trunk prefix after the calling code
+func (plan phonePlan) e164(national string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, national)
+	if plan.trunk != "" {
+		digits = strings.TrimPrefix(digits, plan.trunk)
+	}
+	return "+" + plan.code + digits
 }
 
-func (p Phone) phonenumber() string {
-	randInt, _ := RandomInt(1, 10)
-	str := strings.Join(slice.IntToString(randInt), "")
-	return fmt.Sprintf("%s-%s-%s", str[:3], str[3:6], str[6:10])
+// localeFormats returns the formats of the dataset called name set for the locale with SetDataset, if any,
+// which are used instead of the numbering plans when there is no country tag parameter
+func (p Phone) localeFormats(name string) []string {
+	if _, ok := p.param(CountryParam); ok {
+		return nil
+	}
+	return p.localeDataset(name)
 }
 
-// PhoneNumber generates phone numbers of type: "201-886-0269"
+func (p Phone) phonenumber(plan phonePlan, line string) string {
+	if formats := p.localeFormats(phoneFormatsDataset); len(formats) > 0 {
+		return p.digits(randomElementFromSliceString(p.rnd(), formats))
+	}
+	return p.national(plan, line)
+}
+
+// PhoneNumber generates national phone numbers of type: "201-886-0269", of the country of the locale
+// or of the one set with the country tag parameter, e.g. `faker:"phone_number,country=GB,line=mobile"`
 func (p Phone) PhoneNumber(v reflect.Value) (interface{}, error) {
-	return p.phonenumber(), nil
+	plan, line, err := p.phoneParams()
+	if err != nil {
+		return nil, err
+	}
+	return p.phonenumber(plan, line), nil
 }
 
 // Phonenumber get fake phone number
 func Phonenumber() string {
 	return singleFakeData(PhoneNumber, func() interface{} {
 		p := Phone{}
-		return p.phonenumber()
+		return p.phonenumber(p.localePlan(), "")
 	}).(string)
 }
 
-func (p Phone) tollfreephonenumber() string {
-	out := ""
-	boxDigitsStart := []string{"777", "888"}
-
-	ints, _ := RandomInt(1, 9)
-	for index, v := range slice.IntToString(ints) {
-		if index == 3 {
-			out += "-"
-		}
-		out += v
+func (p Phone) tollfreephonenumber(plan phonePlan) string {
+	if formats := p.localeFormats(tollFreeFormatsDataset); len(formats) > 0 {
+		return p.digits(randomElementFromSliceString(p.rnd(), formats))
 	}
-	return fmt.Sprintf("(%s) %s", boxDigitsStart[rand.Intn(1)], out)
+	return p.digits(randomElementFromSliceString(p.rnd(), plan.tollFree))
 }
 
-// TollFreePhoneNumber generates phone numbers of type: "(888) 937-7238"
+// TollFreePhoneNumber generates toll free phone numbers of type: "(888) 937-7238", see PhoneNumber
 func (p Phone) TollFreePhoneNumber(v reflect.Value) (interface{}, error) {
-	return p.tollfreephonenumber(), nil
+	plan, err := p.plan()
+	if err != nil {
+		return nil, err
+	}
+	return p.tollfreephonenumber(plan), nil
 }
 
 // TollFreePhoneNumber get fake TollFreePhoneNumber
 func TollFreePhoneNumber() string {
 	return singleFakeData(TollFreeNumber, func() interface{} {
 		p := Phone{}
-		return p.tollfreephonenumber()
+		return p.tollfreephonenumber(p.localePlan())
 	}).(string)
 }
 
-func (p Phone) e164PhoneNumber() string {
-	out := ""
-	boxDigitsStart := []string{"7", "8"}
-	ints, _ := RandomInt(1, 10)
-
-	for _, v := range slice.IntToString(ints) {
-		out += v
+func (p Phone) e164PhoneNumber(plan phonePlan, line string) string {
+	if formats := p.localeFormats(e164FormatsDataset); len(formats) > 0 {
+		return p.digits(randomElementFromSliceString(p.rnd(), formats))
 	}
-	return fmt.Sprintf("+%s%s", boxDigitsStart[rand.Intn(1)], strings.Join(slice.IntToString(ints), ""))
+	return plan.e164(p.national(plan, line))
 }
 
-// E164PhoneNumber generates phone numbers of type: "+27113456789"
+// E164PhoneNumber generates phone numbers in the E.164 format of type: "+12018860269", see PhoneNumber
 func (p Phone) E164PhoneNumber(v reflect.Value) (interface{}, error) {
-	return p.e164PhoneNumber(), nil
+	plan, line, err := p.phoneParams()
+	if err != nil {
+		return nil, err
+	}
+	return p.e164PhoneNumber(plan, line), nil
 }
 
 // E164PhoneNumber get fake E164PhoneNumber
 func E164PhoneNumber() string {
 	return singleFakeData(E164PhoneNumberTag, func() interface{} {
 		p := Phone{}
-		return p.e164PhoneNumber()
+		return p.e164PhoneNumber(p.localePlan(), "")
 	}).(string)
 }
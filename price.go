@@ -3,8 +3,11 @@ package faker
 import (
 	"fmt"
 	"math"
-	"math/rand"
 	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/togglhire/faker/v3/support/slice"
 )
 
 // Currency Codes | Source: https://en.wikipedia.org/wiki/ISO_4217
@@ -40,6 +43,7 @@ type Money interface {
 
 // Price struct
 type Price struct {
+	provider
 }
 
 var pri Money
@@ -57,11 +61,14 @@ func GetPrice() Money {
 
 // SetPrice sets custom Money
 func SetPrice(p Money) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	pri = p
 }
 
 func (p Price) currency() string {
-	return randomElementFromSliceString(currencies)
+	return randomElementFromSliceString(p.rnd(), p.dataset(currenciesDataset))
 }
 
 // Currency returns a random currency from currencies
@@ -77,43 +84,282 @@ func Currency() string {
 	}).(string)
 }
 
-func (p Price) amount() float64 {
-	return precision(rand.Float64()*math.Pow10(rand.Intn(8)), rand.Intn(2)+1)
+// currencyMinorUnits are the ISO 4217 minor units of the currencies that do not have 2 of them
+var currencyMinorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+	// the precious metals, the funds and the codes for testing have no minor unit
+	"XAG": 0, "XAU": 0, "XBA": 0, "XBB": 0, "XBC": 0, "XBD": 0, "XDR": 0, "XPD": 0, "XPT": 0,
+	"XSU": 0, "XTS": 0, "XUA": 0, "XXX": 0,
+}
+
+// currencySymbols are the symbols written before or after the formatted amounts, the other currencies are written with their code
+var currencySymbols = map[string]string{
+	"BRL": "R$", "EUR": "€", "GBP": "£", "ILS": "₪", "INR": "₹", "JPY": "¥", "KRW": "₩",
+	"NGN": "₦", "PHP": "₱", "RUB": "₽", "TRY": "₺", "UAH": "₴", "USD": "$", "VND": "₫",
+}
+
+// minorUnits returns the number of decimals of currency, 2 when it is unknown
+func minorUnits(currency string) int {
+	if units, ok := currencyMinorUnits[currency]; ok {
+		return units
+	}
+	return 2
+}
+
+// MoneyAmount is an amount of money rounded to the minor unit of its currency
+type MoneyAmount struct {
+	Amount   float64
+	Currency string
+	// MinorUnits is the amount in the minor unit of the currency, e.g. in cents
+	MinorUnits int64
+	// Formatted is the amount written in the way of the locale, e.g. $1,234.56 or 1.234,56 €
+	Formatted string
+}
+
+// amountCurrency returns the currency set with the currency tag parameter, or the one of the locale
+// when it has a single one, otherwise an empty string
+func (p Price) amountCurrency() (string, error) {
+	if currency, ok := p.param(CurrencyParam); ok {
+		if !p.knownCurrency(currency) {
+			return "", fmt.Errorf(ErrUnknownCurrency, currency)
+		}
+		return currency, nil
+	}
+	if values := p.dataset(currenciesDataset); len(values) == 1 {
+		return values[0], nil
+	}
+	return "", nil
+}
+
+// knownCurrency tells whether currency is an ISO 4217 code or one of the currencies dataset
+func (p Price) knownCurrency(currency string) bool {
+	return slice.Contains(currencies, currency) || slice.Contains(p.dataset(currenciesDataset), currency)
 }
 
-// Amount returns a random floating price amount
-// with a random precision of [1,2] up to (10**8 - 1)
+// randomMinor returns a random amount in the minor unit of currency, up to (10**7 - 1) in the major unit
+// and at most limit
+func (p Price) randomMinor(currency string, limit int64) int64 {
+	if minor := int64(p.rnd().Float64() * math.Pow10(p.rnd().Intn(8)) * math.Pow10(minorUnits(currency))); minor <= limit {
+		return minor
+	}
+	return p.rnd().Int63n(limit + 1)
+}
+
+// minorAmount returns a random amount in the minor unit of currency, within the min and max tag parameters
+// when they are set, which are written in the major unit, e.g. min=9.99. Without max, it is at most limit.
+func (p Price) minorAmount(currency string, limit int64) (int64, error) {
+	minValue, hasMin := p.param(MinParam)
+	maxValue, hasMax := p.param(MaxParam)
+	if !hasMin && !hasMax {
+		return p.randomMinor(currency, limit), nil
+	}
+	scale := math.Pow10(minorUnits(currency))
+
+	low := 0.0
+	if hasMin {
+		value, err := strconv.ParseFloat(minValue, 64)
+		if err != nil || math.IsNaN(value) || math.Abs(value) > 1e12 {
+			return 0, fmt.Errorf(ErrWrongFormattedTag, MinParam+Equals+minValue)
+		}
+		low = value
+	}
+	high := math.Max(low, 0) + 1e7
+	if hasMax {
+		value, err := strconv.ParseFloat(maxValue, 64)
+		if err != nil || math.IsNaN(value) || math.Abs(value) > 1e12 {
+			return 0, fmt.Errorf(ErrWrongFormattedTag, MaxParam+Equals+maxValue)
+		}
+		high = value
+	}
+	lowMinor, highMinor := int64(math.Ceil(low*scale)), int64(math.Floor(high*scale))
+	if !hasMax && highMinor > limit {
+		highMinor = limit
+	}
+	if lowMinor > highMinor {
+		return 0, fmt.Errorf(ErrWrongFormattedTag, MinParam+Equals+minValue+comma+MaxParam+Equals+maxValue)
+	}
+	return lowMinor + p.rnd().Int63n(highMinor-lowMinor+1), nil
+}
+
+// amount returns a random amount and the same amount in minor units, which is at most limit unless max is set
+func (p Price) amount(limit int64) (float64, int64, error) {
+	currency, err := p.amountCurrency()
+	if err != nil {
+		return 0, 0, err
+	}
+	minor, err := p.minorAmount(currency, limit)
+	if err != nil {
+		return 0, 0, err
+	}
+	return float64(minor) / math.Pow10(minorUnits(currency)), minor, nil
+}
+
+// Amount returns a random price amount up to (10**7 - 1), rounded to the minor unit of the currency set with the
+// currency tag parameter or of the locale, to 2 decimals otherwise. The min and max tag parameters bound it.
+// Integer fields get the amount in the minor unit, e.g. in cents, up to the largest value they hold without max.
 func (p Price) Amount(v reflect.Value) (interface{}, error) {
-	kind := v.Kind()
-	val := p.amount()
-	if kind == reflect.Float32 {
-		v.Set(reflect.ValueOf(float32(val)))
+	limit := int64(math.MaxInt64)
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		limit = 1<<uint(v.Type().Bits()-1) - 1
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		limit = 1<<uint(v.Type().Bits()) - 1
+	case reflect.Int, reflect.Uint:
+		if v.Type().Bits() == 32 {
+			limit = math.MaxInt32
+		}
+	}
+	val, minor, err := p.amount(limit)
+	if err != nil {
+		return nil, err
+	}
+	switch v.Kind() {
+	case reflect.Float32:
 		return float32(val), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.OverflowInt(minor) {
+			return nil, fmt.Errorf(ErrAmountOverflow, minor, v.Type())
+		}
+		return reflect.ValueOf(minor).Convert(v.Type()).Interface(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if minor < 0 || v.OverflowUint(uint64(minor)) {
+			return nil, fmt.Errorf(ErrAmountOverflow, minor, v.Type())
+		}
+		return reflect.ValueOf(minor).Convert(v.Type()).Interface(), nil
 	}
-	v.Set(reflect.ValueOf(val))
 	return val, nil
 }
 
-func (p Price) amountwithcurrency() string {
-	val := p.amount()
-	return fmt.Sprintf("%s %f", p.currency(), val)
+// money returns the MoneyAmount of an amount in the minor unit of currency
+func (p Price) money(currency string, minor int64) MoneyAmount {
+	return MoneyAmount{
+		Amount:     float64(minor) / math.Pow10(minorUnits(currency)),
+		Currency:   currency,
+		MinorUnits: minor,
+		Formatted:  formatMoney(p.locale(), minor, currency),
+	}
+}
+
+// randomMoney returns a random amount in a random currency of the locale
+func (p Price) randomMoney() MoneyAmount {
+	currency := p.currency()
+	return p.money(currency, p.randomMinor(currency, math.MaxInt64))
 }
 
-// AmountWithCurrency combines both price and currency together
+// moneyAmount returns an amount in the currency set with the currency tag parameter, or in a random one of the locale,
+// within the min and max tag parameters
+func (p Price) moneyAmount() (MoneyAmount, error) {
+	currency, ok := p.param(CurrencyParam)
+	if !ok {
+		currency = p.currency()
+	}
+	if !p.knownCurrency(currency) {
+		return MoneyAmount{}, fmt.Errorf(ErrUnknownCurrency, currency)
+	}
+	minor, err := p.minorAmount(currency, math.MaxInt64)
+	if err != nil {
+		return MoneyAmount{}, err
+	}
+	return p.money(currency, minor), nil
+}
+
+// formatMoney writes an amount in the minor unit of currency with the separators and the currency pattern of l
+func formatMoney(l *locale, minor int64, currency string) string {
+	format := l.moneyFormat
+	if format.pattern == "" {
+		format = bundledMoneyFormat
+	}
+
+	sign := ""
+	if minor < 0 {
+		sign, minor = "-", -minor
+	}
+	digits := strconv.FormatInt(minor, 10)
+	units := minorUnits(currency)
+	if len(digits) <= units {
+		digits = strings.Repeat("0", units-len(digits)+1) + digits
+	}
+	integer, decimals := digits[:len(digits)-units], digits[len(digits)-units:]
+	var b strings.Builder
+	for k, d := range integer {
+		if k > 0 && (len(integer)-k)%3 == 0 {
+			b.WriteString(format.group)
+		}
+		b.WriteRune(d)
+	}
+	if units > 0 {
+		b.WriteString(format.decimal + decimals)
+	}
+
+	pattern := format.pattern
+	symbol, ok := currencySymbols[currency]
+	if !ok {
+		// the codes are kept apart from the amount
+		symbol = currency
+		pattern = strings.Replace(strings.Replace(pattern, "¤#", "¤ #", 1), "#¤", "# ¤", 1)
+	}
+	return sign + strings.NewReplacer("¤", symbol, "#", b.String()).Replace(pattern)
+}
+
+func amountwithcurrency(m MoneyAmount) string {
+	return fmt.Sprintf("%s %.*f", m.Currency, minorUnits(m.Currency), m.Amount)
+}
+
+// AmountWithCurrency combines both price and currency together, e.g. USD 49257.10 or JPY 4925
 func (p Price) AmountWithCurrency(v reflect.Value) (interface{}, error) {
-	return p.amountwithcurrency(), nil
+	m, err := p.moneyAmount()
+	if err != nil {
+		return nil, err
+	}
+	return amountwithcurrency(m), nil
 }
 
-// AmountWithCurrency get fake AmountWithCurrency  USD 49257.100
+// AmountWithCurrency get fake AmountWithCurrency  USD 49257.10
 func AmountWithCurrency() string {
 	return singleFakeData(AmountWithCurrencyTag, func() interface{} {
 		p := Price{}
-		return p.amountwithcurrency()
+		return amountwithcurrency(p.randomMoney())
+	}).(string)
+}
+
+// FormattedAmount returns an amount with its currency written in the way of the locale, e.g. $49,257.10 or 49.257,10 €
+func (p Price) FormattedAmount(v reflect.Value) (interface{}, error) {
+	m, err := p.moneyAmount()
+	if err != nil {
+		return nil, err
+	}
+	return m.Formatted, nil
+}
+
+// FormattedAmount get fake FormattedAmount $49,257.10
+func FormattedAmount() string {
+	return singleFakeData(FormattedAmountTag, func() interface{} {
+		p := Price{}
+		return p.randomMoney().Formatted
 	}).(string)
 }
 
-// precision | a helper function to set precision of price
-func precision(val float64, pre int) float64 {
-	div := math.Pow10(pre)
-	return float64(int64(val*div)) / div
+// MoneyAmount returns a MoneyAmount in the currency set with the currency tag parameter, or in a random one of the locale,
+// within the min and max tag parameters, e.g. `faker:"money,currency=EUR,min=10,max=99.99"`.
+// String fields get its formatted amount.
+func (p Price) MoneyAmount(v reflect.Value) (interface{}, error) {
+	m, err := p.moneyAmount()
+	if err != nil {
+		return nil, err
+	}
+	if v.Kind() == reflect.String {
+		return m.Formatted, nil
+	}
+	return m, nil
+}
+
+// FakeMoneyAmount get fake MoneyAmount
+func FakeMoneyAmount() MoneyAmount {
+	return singleFakeData(MoneyTag, func() interface{} {
+		p := Price{}
+		return p.randomMoney()
+	}).(MoneyAmount)
 }
@@ -1,6 +1,8 @@
 package faker
 
 import (
+	"fmt"
+	"math"
 	"reflect"
 	"strings"
 	"testing"
@@ -53,3 +55,166 @@ func TestFakeAmountWithCurrency(t *testing.T) {
 		t.Error("Expected a currency code from currencies")
 	}
 }
+
+func TestAmountMinorUnits(t *testing.T) {
+	for currency, units := range map[string]int{"JPY": 0, "USD": 2, "EUR": 2, "KWD": 3, "CLF": 4} {
+		p := Price{provider{params: map[string]string{CurrencyParam: currency}}}
+		scale := math.Pow10(units)
+		for i := 0; i < 50; i++ {
+			val, err := p.Amount(reflect.ValueOf(0.0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if amount := val.(float64); math.Round(amount*scale)/scale != amount {
+				t.Errorf("%s: expected at most %d decimals, but got %v", currency, units, val)
+			}
+		}
+		s, err := p.AmountWithCurrency(reflect.Value{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		amount := strings.TrimPrefix(s.(string), currency+" ")
+		if i := strings.Index(amount, "."); (units == 0 && i >= 0) || (units > 0 && len(amount)-i-1 != units) {
+			t.Errorf("%s: expected %d decimals, but got %s", currency, units, s)
+		}
+	}
+}
+
+func TestAmountBoundaries(t *testing.T) {
+	var r struct {
+		Price  float64 `faker:"amount,min=9.99,max=10.01"`
+		Yen    float32 `faker:"amount,currency=JPY,min=100,max=200"`
+		Cents  int64   `faker:"amount,currency=USD,min=1,max=2"`
+		Fils   int     `faker:"amount,currency=KWD,min=1,max=1"`
+		Over   float64 `faker:"amount,min=1e6"`
+		Signed float64 `faker:"amount,min=-5,max=-1"`
+	}
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		if r.Price < 9.99 || r.Price > 10.01 {
+			t.Errorf("expected a price between 9.99 and 10.01, but got %v", r.Price)
+		}
+		if r.Yen < 100 || r.Yen > 200 || r.Yen != float32(math.Trunc(float64(r.Yen))) {
+			t.Errorf("expected whole yens between 100 and 200, but got %v", r.Yen)
+		}
+		if r.Cents < 100 || r.Cents > 200 {
+			t.Errorf("expected between 100 and 200 cents, but got %d", r.Cents)
+		}
+		if r.Fils != 1000 {
+			t.Errorf("expected 1000 fils, but got %d", r.Fils)
+		}
+		if r.Over < 1e6 {
+			t.Errorf("expected at least 1e6, but got %v", r.Over)
+		}
+		if r.Signed < -5 || r.Signed > -1 {
+			t.Errorf("expected an amount between -5 and -1, but got %v", r.Signed)
+		}
+	}
+
+	var wrong struct {
+		Amount float64 `faker:"amount,min=10,max=1"`
+	}
+	if err := FakeData(&wrong); err == nil {
+		t.Error("expected an error for a min above the max")
+	}
+	var unknown struct {
+		Amount float64 `faker:"amount,currency=ABC"`
+	}
+	if err := FakeData(&unknown); err == nil || err.Error() != fmt.Sprintf(ErrUnknownCurrency, "ABC") {
+		t.Errorf("expected an unknown currency error, but got %v", err)
+	}
+}
+
+func TestAmountSmallIntegers(t *testing.T) {
+	var r struct {
+		Int8   int8   `faker:"amount,currency=USD"`
+		Uint8  uint8  `faker:"amount,currency=USD"`
+		Int16  int16  `faker:"amount,currency=JPY,min=100"`
+		Uint32 uint32 `faker:"amount,currency=USD"`
+	}
+	for i := 0; i < 200; i++ {
+		if err := FakeData(&r); err != nil {
+			t.Fatal(err)
+		}
+		if r.Int8 < 0 || r.Int16 < 100 {
+			t.Errorf("expected positive amounts, but got %d and %d", r.Int8, r.Int16)
+		}
+	}
+
+	var overflow struct {
+		Amount int8 `faker:"amount,currency=USD,min=2,max=3"`
+	}
+	if err := FakeData(&overflow); err == nil || !strings.HasSuffix(err.Error(), "overflows int8") {
+		t.Errorf("expected an overflow error, but got %v", err)
+	}
+	var negative struct {
+		Amount uint16 `faker:"amount,currency=USD,min=-2,max=-1"`
+	}
+	if err := FakeData(&negative); err == nil {
+		t.Error("expected an overflow error for a negative amount, but got nil")
+	}
+}
+
+func TestFormatMoney(t *testing.T) {
+	tests := []struct {
+		locale   string
+		minor    int64
+		currency string
+		want     string
+	}{
+		{"en_US", 123456, "USD", "$1,234.56"},
+		{"en_US", 123456, "EUR", "€1,234.56"},
+		{"en_US", 5, "USD", "$0.05"},
+		{"en_US", -123456789, "USD", "-$1,234,567.89"},
+		{"en_US", 123456, "CHF", "CHF 1,234.56"},
+		{"en_US", 1234567, "KWD", "KWD 1,234.567"},
+		{"de_DE", 123456, "EUR", "1.234,56 €"},
+		{"de_DE", 123456, "CHF", "1.234,56 CHF"},
+		{"fr_FR", 123456789, "EUR", "1 234 567,89 €"},
+		{"ja_JP", 1234567, "JPY", "¥1,234,567"},
+		{"pt_BR", 123, "BRL", "R$ 1,23"},
+	}
+	for _, test := range tests {
+		l, err := findLocale(test.locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := formatMoney(l, test.minor, test.currency); got != test.want {
+			t.Errorf("%s %d %s: expected %s, but got %s", test.locale, test.minor, test.currency, test.want, got)
+		}
+	}
+}
+
+func TestMoneyAmount(t *testing.T) {
+	var r struct {
+		Money     MoneyAmount  `faker:"money,currency=EUR,min=1000,max=2000"`
+		Pointer   *MoneyAmount `faker:"money"`
+		Formatted string       `faker:"money,currency=EUR,min=1000,max=2000"`
+		Amount    string       `faker:"formatted_amount"`
+	}
+	if err := FakeData(&r, WithLocale("de_DE")); err != nil {
+		t.Fatal(err)
+	}
+	m := r.Money
+	if m.Currency != "EUR" || m.Amount < 1000 || m.Amount > 2000 || m.MinorUnits != int64(math.Round(m.Amount*100)) {
+		t.Errorf("expected an amount of EUR between 1000 and 2000, but got %+v", m)
+	}
+	if !strings.HasSuffix(m.Formatted, " €") || !strings.Contains(m.Formatted, ".") || !strings.Contains(m.Formatted, ",") {
+		t.Errorf("expected a German formatted amount, but got %s", m.Formatted)
+	}
+	if r.Pointer == nil || r.Pointer.Currency != "EUR" {
+		t.Errorf("expected a pointer to an amount of EUR, the currency of de_DE, but got %+v", r.Pointer)
+	}
+	if !strings.HasSuffix(r.Formatted, " €") || !strings.HasSuffix(r.Amount, " €") {
+		t.Errorf("expected German formatted amounts, but got %s and %s", r.Formatted, r.Amount)
+	}
+
+	if m := FakeMoneyAmount(); !slice.Contains(currencies, m.Currency) || m.Formatted == "" {
+		t.Errorf("expected a money amount, but got %+v", m)
+	}
+	if s := FormattedAmount(); s == "" {
+		t.Error("expected a formatted amount")
+	}
+}
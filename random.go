@@ -0,0 +1,214 @@
+package faker
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// globalRand draws from the global source of math/rand, so that SetSeed drives the values generated without a source
+// of their own. Its Read method is not safe for concurrent use and must not be called.
+var globalRand = rand.New(globalSource{})
+
+type globalSource struct{}
+
+func (globalSource) Int63() int64 { return rand.Int63() }
+
+func (globalSource) Uint64() uint64 { return rand.Uint64() }
+
+func (globalSource) Seed(seed int64) { rand.Seed(seed) }
+
+// provider holds the state of a single generation used by the built-in providers
+type provider struct {
+	r      *rand.Rand
+	loc    *locale
+	params map[string]string
+	seq    *Sequence
+	clock  func() time.Time
+	card   *creditCard
+}
+
+// rnd returns the random source of the generation, the global one when it has none
+func (p provider) rnd() *rand.Rand {
+	if p.r == nil {
+		return globalRand
+	}
+	return p.r
+}
+
+// param returns the value of the tag parameter called name of the field being generated
+func (p provider) param(name string) (string, bool) {
+	value, ok := p.params[name]
+	return value, ok
+}
+
+// builtinTags binds the built-in tag providers to the state of a generation
+var builtinTags = map[string]func(p provider) TaggedFunction{
+	EmailTag:              func(p provider) TaggedFunction { return Internet{p}.Email },
+	MacAddressTag:         func(p provider) TaggedFunction { return Internet{p}.MacAddress },
+	DomainNameTag:         func(p provider) TaggedFunction { return Internet{p}.DomainName },
+	URLTag:                func(p provider) TaggedFunction { return Internet{p}.URL },
+	UserNameTag:           func(p provider) TaggedFunction { return Internet{p}.UserName },
+	IPV4Tag:               func(p provider) TaggedFunction { return Internet{p}.IPv4 },
+	IPV6Tag:               func(p provider) TaggedFunction { return Internet{p}.IPv6 },
+	PASSWORD:              func(p provider) TaggedFunction { return Internet{p}.Password },
+	CreditCardType:        func(p provider) TaggedFunction { return Payment{p}.CreditCardType },
+	CreditCardNumber:      func(p provider) TaggedFunction { return Payment{p}.CreditCardNumber },
+	CreditCardExpiry:      func(p provider) TaggedFunction { return Payment{p}.CreditCardExpiry },
+	CreditCardCVV:         func(p provider) TaggedFunction { return Payment{p}.CreditCardCVV },
+	CreditCardHolder:      func(p provider) TaggedFunction { return Payment{p}.CreditCardHolder },
+	CreditCardTag:         func(p provider) TaggedFunction { return Payment{p}.CreditCard },
+	IBANTag:               func(p provider) TaggedFunction { return Payment{p}.IBAN },
+	BICTag:                func(p provider) TaggedFunction { return Payment{p}.BIC },
+	ABARoutingNumberTag:   func(p provider) TaggedFunction { return Payment{p}.ABARoutingNumber },
+	UKSortCodeTag:         func(p provider) TaggedFunction { return Payment{p}.UKSortCode },
+	UKAccountNumberTag:    func(p provider) TaggedFunction { return Payment{p}.UKAccountNumber },
+	LATITUDE:              func(p provider) TaggedFunction { return Address{p}.Latitude },
+	LONGITUDE:             func(p provider) TaggedFunction { return Address{p}.Longitude },
+	StreetAddressTag:      func(p provider) TaggedFunction { return Address{p}.StreetAddress },
+	StreetNameTag:         func(p provider) TaggedFunction { return Address{p}.StreetName },
+	BuildingNumberTag:     func(p provider) TaggedFunction { return Address{p}.BuildingNumber },
+	SecondaryAddressTag:   func(p provider) TaggedFunction { return Address{p}.SecondaryAddress },
+	CityTag:               func(p provider) TaggedFunction { return Address{p}.City },
+	StateTag:              func(p provider) TaggedFunction { return Address{p}.State },
+	StateAbbrTag:          func(p provider) TaggedFunction { return Address{p}.StateAbbr },
+	PostcodeTag:           func(p provider) TaggedFunction { return Address{p}.Postcode },
+	CountryTag:            func(p provider) TaggedFunction { return Address{p}.Country },
+	CountryCodeTag:        func(p provider) TaggedFunction { return Address{p}.CountryCode },
+	CountryCodeAlpha3Tag:  func(p provider) TaggedFunction { return Address{p}.CountryCodeAlpha3 },
+	FormattedAddressTag:   func(p provider) TaggedFunction { return Address{p}.FormattedAddress },
+	RealAddressTag:        func(p provider) TaggedFunction { return Address{p}.RealAddress },
+	LatLngTag:             func(p provider) TaggedFunction { return Address{p}.LatLng },
+	GeoJSONPointTag:       func(p provider) TaggedFunction { return Address{p}.GeoJSONPoint },
+	GeoJSONLineStringTag:  func(p provider) TaggedFunction { return Address{p}.GeoJSONLineString },
+	GeoJSONPolygonTag:     func(p provider) TaggedFunction { return Address{p}.GeoJSONPolygon },
+	PhoneNumber:           func(p provider) TaggedFunction { return Phone{p}.PhoneNumber },
+	TollFreeNumber:        func(p provider) TaggedFunction { return Phone{p}.TollFreePhoneNumber },
+	E164PhoneNumberTag:    func(p provider) TaggedFunction { return Phone{p}.E164PhoneNumber },
+	TitleMaleTag:          func(p provider) TaggedFunction { return Person{p}.TitleMale },
+	TitleFemaleTag:        func(p provider) TaggedFunction { return Person{p}.TitleFeMale },
+	FirstNameTag:          func(p provider) TaggedFunction { return Person{p}.FirstName },
+	FirstNameMaleTag:      func(p provider) TaggedFunction { return Person{p}.FirstNameMale },
+	FirstNameFemaleTag:    func(p provider) TaggedFunction { return Person{p}.FirstNameFemale },
+	LastNameTag:           func(p provider) TaggedFunction { return Person{p}.LastName },
+	NAME:                  func(p provider) TaggedFunction { return Person{p}.Name },
+	BirthdateTag:          func(p provider) TaggedFunction { return Person{p}.Birthdate },
+	AgeTag:                func(p provider) TaggedFunction { return Person{p}.Age },
+	UnixTimeTag:           func(p provider) TaggedFunction { return DateTime{p}.UnixTime },
+	DATE:                  func(p provider) TaggedFunction { return DateTime{p}.Date },
+	TIME:                  func(p provider) TaggedFunction { return DateTime{p}.Time },
+	MonthNameTag:          func(p provider) TaggedFunction { return DateTime{p}.MonthName },
+	YEAR:                  func(p provider) TaggedFunction { return DateTime{p}.Year },
+	DayOfWeekTag:          func(p provider) TaggedFunction { return DateTime{p}.DayOfWeek },
+	DayOfMonthTag:         func(p provider) TaggedFunction { return DateTime{p}.DayOfMonth },
+	TIMESTAMP:             func(p provider) TaggedFunction { return DateTime{p}.Timestamp },
+	CENTURY:               func(p provider) TaggedFunction { return DateTime{p}.Century },
+	TIMEZONE:              func(p provider) TaggedFunction { return DateTime{p}.TimeZone },
+	TimePeriodTag:         func(p provider) TaggedFunction { return DateTime{p}.TimePeriod },
+	WORD:                  func(p provider) TaggedFunction { return Lorem{p}.Word },
+	SENTENCE:              func(p provider) TaggedFunction { return Lorem{p}.Sentence },
+	PARAGRAPH:             func(p provider) TaggedFunction { return Lorem{p}.Paragraph },
+	CurrencyTag:           func(p provider) TaggedFunction { return Price{p}.Currency },
+	AmountTag:             func(p provider) TaggedFunction { return Price{p}.Amount },
+	AmountWithCurrencyTag: func(p provider) TaggedFunction { return Price{p}.AmountWithCurrency },
+	FormattedAmountTag:    func(p provider) TaggedFunction { return Price{p}.FormattedAmount },
+	MoneyTag:              func(p provider) TaggedFunction { return Price{p}.MoneyAmount },
+	DatasetTag:            func(p provider) TaggedFunction { return p.datasetValue },
+	ID:                    func(p provider) TaggedFunction { return UUID{p}.Digit },
+	HyphenatedID:          func(p provider) TaggedFunction { return UUID{p}.Hyphenated },
+	UUIDV1Tag:             func(p provider) TaggedFunction { return UUID{p}.UUIDv1 },
+	UUIDV3Tag:             func(p provider) TaggedFunction { return UUID{p}.UUIDv3 },
+	UUIDV5Tag:             func(p provider) TaggedFunction { return UUID{p}.UUIDv5 },
+	UUIDV6Tag:             func(p provider) TaggedFunction { return UUID{p}.UUIDv6 },
+	UUIDV7Tag:             func(p provider) TaggedFunction { return UUID{p}.UUIDv7 },
+	ULIDTag:               func(p provider) TaggedFunction { return UUID{p}.ULID },
+	KSUIDTag:              func(p provider) TaggedFunction { return UUID{p}.KSUID },
+	ObjectIDTag:           func(p provider) TaggedFunction { return UUID{p}.ObjectID },
+	SnowflakeTag:          func(p provider) TaggedFunction { return UUID{p}.Snowflake },
+	NanoIDTag:             func(p provider) TaggedFunction { return UUID{p}.NanoID },
+}
+
+func randomString(r *rand.Rand, n int) string {
+	b := make([]byte, n)
+	for i, cache, remain := n-1, r.Int63(), letterIdxMax; i >= 0; {
+		if remain == 0 {
+			cache, remain = r.Int63(), letterIdxMax
+		}
+		if idx := int(cache & letterIdxMask); idx < len(letterBytes) {
+			b[i] = letterBytes[idx]
+			i--
+		}
+		cache >>= letterIdxBits
+		remain--
+	}
+
+	return string(b)
+}
+
+// randomIntegerWithBoundary returns a random integer between input start and end boundary. [start, end)
+func randomIntegerWithBoundary(r *rand.Rand, boundary numberBoundary) int {
+	return r.Intn(boundary.end-boundary.start) + boundary.start
+}
+
+// randomInteger returns a random integer between start and end boundary. [start, end)
+func randomInteger(r *rand.Rand) int {
+	return randomIntegerWithBoundary(r, numberBoundaries())
+}
+
+// randomSliceAndMapSize returns a random integer between [0,randomSliceAndMapSize). If the testRandZero is set, returns 0
+// Written for test purposes for shouldSetNil
+func randomSliceAndMapSize(r *rand.Rand) int {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+
+	if testRandZero {
+		return 0
+	}
+	if isFixedSize {
+		return randomSize
+	}
+	return r.Intn(randomSize)
+}
+
+func randomElementFromSliceString(r *rand.Rand, s []string) string {
+	return s[r.Int()%len(s)]
+}
+
+func randomStringNumber(r *rand.Rand, n int) string {
+	b := make([]byte, n)
+	for i, cache, remain := n-1, r.Int63(), letterIdxMax; i >= 0; {
+		if remain == 0 {
+			cache, remain = r.Int63(), letterIdxMax
+		}
+		if idx := int(cache & letterIdxMask); idx < len(numberBytes) {
+			b[i] = numberBytes[idx]
+			i--
+		}
+		cache >>= letterIdxBits
+		remain--
+	}
+
+	return string(b)
+}
+
+// randomInt is RandomInt drawing from r
+func randomInt(r *rand.Rand, parameters ...int) (p []int, err error) {
+	switch len(parameters) {
+	case 1:
+		minCount := parameters[0]
+		p = r.Perm(minCount)
+		for i := range p {
+			p[i] += minCount
+		}
+	case 2:
+		minDigit, maxDigit := parameters[0], parameters[1]
+		p = r.Perm(maxDigit - minDigit + 1)
+
+		for i := range p {
+			p[i] += minDigit
+		}
+	default:
+		err = fmt.Errorf(ErrMoreArguments, len(parameters))
+	}
+	return p, err
+}
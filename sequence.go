@@ -0,0 +1,143 @@
+package faker
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Sequence hands out strictly increasing times to the unix_time and timestamp tags and to the time ordered IDs,
+// e.g. to generate the records of an event log in order:
+//
+//	seq := faker.NewSequence(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Minute)
+//	for i := range events {
+//		err := faker.FakeData(&events[i], faker.Monotonic(seq))
+//	}
+//
+// Each value is greater than the previous one at its own precision: seconds for unix_time, timestamp, KSUID and
+// ObjectID, milliseconds for UUID v7, ULID and Snowflake and 100 nanoseconds for UUID v1 and v6.
+// A Sequence can be shared by concurrent goroutines. With FakeMany, the records get increasing values in their order
+// whatever the number of workers, and a record generated again for its unique values gets the same values.
+type Sequence struct {
+	mu      sync.Mutex
+	start   time.Time
+	maxStep time.Duration
+	last    time.Time
+	started bool
+
+	// The Sequences of the records of FakeMany take their times from parent once the record before them is done,
+	// see recordSequences. taken are the times of the record and replayed the ones handed out again, see replay.
+	parent   *Sequence
+	after    <-chan struct{}
+	done     chan struct{}
+	taken    []time.Time
+	replayed []time.Time
+}
+
+// currentSequence is the Sequence set with SetMonotonic, guarded by settingsMu
+var currentSequence *Sequence
+
+// NewSequence returns a Sequence whose first time is start and whose next ones are after the previous one by a random
+// step of up to maxStep. The step is at least the precision of the value, e.g. one second for unix_time.
+func NewSequence(start time.Time, maxStep time.Duration) *Sequence {
+	return &Sequence{start: start, maxStep: maxStep}
+}
+
+// next returns the first time of the sequence, then a time after the previous one, truncated to precision,
+// by a random step between precision and the max step of the sequence
+func (s *Sequence) next(r *rand.Rand, precision time.Duration) time.Time {
+	if s.parent != nil {
+		return s.recordNext(r, precision)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		s.started = true
+		s.last = s.start
+		return s.last
+	}
+	step := precision
+	if s.maxStep > precision {
+		step *= 1 + time.Duration(r.Int63n(int64(s.maxStep/precision)))
+	}
+	s.last = s.last.Truncate(precision).Add(step)
+	return s.last
+}
+
+// recordSequences returns the Sequences of n records generated concurrently, which take their times from s in the
+// order of the records: the first time of a record is taken once the records before it are done, see finish
+func (s *Sequence) recordSequences(n int) []*Sequence {
+	seqs := make([]*Sequence, n)
+	after := make(chan struct{})
+	close(after)
+	for i := range seqs {
+		seqs[i] = &Sequence{parent: s, after: after, done: make(chan struct{})}
+		after = seqs[i].done
+	}
+	return seqs
+}
+
+// recordNext returns the next time of the record, the ones it took before when it is generated again
+func (s *Sequence) recordNext(r *rand.Rand, precision time.Duration) time.Time {
+	<-s.after
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.replayed) > 0 {
+		t := s.replayed[0]
+		s.replayed = s.replayed[1:]
+		return t
+	}
+	t := s.parent.next(r, precision)
+	s.taken = append(s.taken, t)
+	return t
+}
+
+// finish marks the record done once the records before it are, so that the record after it takes its times
+func (s *Sequence) finish() {
+	<-s.after
+	close(s.done)
+}
+
+// replay returns a Sequence handing out the times taken by the record again, to generate it again once it is done
+func (s *Sequence) replay() *Sequence {
+	return &Sequence{parent: s.parent, after: s.done, replayed: s.taken}
+}
+
+// SetMonotonic makes the unix_time and timestamp tags and the time ordered IDs take their times from seq,
+// including in the single fake data functions, see Sequence. A nil seq turns the monotonic mode off.
+func SetMonotonic(seq *Sequence) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
+	currentSequence = seq
+}
+
+// Monotonic makes the unix_time and timestamp tags and the time ordered IDs of the call take their times from seq
+// instead of the one set with SetMonotonic, see Sequence
+func Monotonic(seq *Sequence) Option {
+	return func(o *options) {
+		o.seq = seq
+	}
+}
+
+// sequence returns the Sequence of the generation, the one set with SetMonotonic when it has none
+func (p provider) sequence() *Sequence {
+	if p.seq != nil {
+		return p.seq
+	}
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+
+	return currentSequence
+}
+
+// now returns the time of the values based on the current time, see SetClock,
+// or the next time of the Sequence of the generation, at the given precision, in monotonic mode
+func (p provider) now(precision time.Duration) time.Time {
+	if seq := p.sequence(); seq != nil {
+		return seq.next(p.rnd(), precision)
+	}
+	return p.currentTime()
+}
@@ -0,0 +1,140 @@
+package faker
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type Event struct {
+	UnixTime  int64  `faker:"unix_time"`
+	Timestamp string `faker:"timestamp"`
+	UUIDv6    string `faker:"uuid_v6"`
+	UUIDv7    string `faker:"uuid_v7"`
+	ULID      string `faker:"ulid"`
+	ObjectID  string `faker:"object_id"`
+	Snowflake int64  `faker:"snowflake"`
+}
+
+func TestMonotonic(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	seq := NewSequence(start, time.Minute)
+	var prev Event
+	for i := 0; i < 100; i++ {
+		var e Event
+		if err := FakeData(&e, Monotonic(seq)); err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			if e.UnixTime != start.Unix() {
+				t.Errorf("expected the first unix time to be the start of the sequence, but got %d", e.UnixTime)
+			}
+		} else if e.UnixTime <= prev.UnixTime || e.Timestamp <= prev.Timestamp || e.UUIDv6 <= prev.UUIDv6 ||
+			e.UUIDv7 <= prev.UUIDv7 || e.ULID <= prev.ULID || e.ObjectID <= prev.ObjectID || e.Snowflake <= prev.Snowflake {
+			t.Errorf("expected %+v to be greater than %+v", e, prev)
+		}
+		if e.UnixTime-prev.UnixTime > 7*60 && i > 0 {
+			t.Errorf("expected steps of up to a minute, but got %d seconds between %d and %d", e.UnixTime-prev.UnixTime, prev.UnixTime, e.UnixTime)
+		}
+		prev = e
+	}
+}
+
+func TestMonotonicStep(t *testing.T) {
+	var r struct {
+		UnixTime int64 `faker:"unix_time"`
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	seq := NewSequence(start, 0)
+	for i := 0; i < 10; i++ {
+		if err := FakeData(&r, Monotonic(seq)); err != nil {
+			t.Fatal(err)
+		}
+		if r.UnixTime != start.Unix()+int64(i) {
+			t.Errorf("expected steps of one second from %d, but got %d at %d", start.Unix(), r.UnixTime, i)
+		}
+	}
+}
+
+func TestSetMonotonic(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	SetMonotonic(NewSequence(start, time.Hour))
+	defer SetMonotonic(nil)
+
+	prev := UnixTime()
+	if prev != start.Unix() {
+		t.Errorf("expected the first unix time to be the start of the sequence, but got %d", prev)
+	}
+	for i := 0; i < 20; i++ {
+		ts := UnixTime()
+		if ts <= prev {
+			t.Errorf("expected a unix time after %d, but got %d", prev, ts)
+		}
+		prev = ts
+	}
+	if ts := Timestamp(); ts <= time.Unix(prev, 0).UTC().Format("2006-01-02 15:04:05") {
+		t.Errorf("expected a timestamp after %d, but got %s", prev, ts)
+	}
+}
+
+func TestMonotonicFakeMany(t *testing.T) {
+	seq := NewSequence(time.Now(), time.Second)
+	var events []Event
+	if err := FakeMany(&events, 50, Monotonic(seq), Seed(7)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < len(events); i++ {
+		if events[i].UUIDv7 <= events[i-1].UUIDv7 || events[i].UnixTime <= events[i-1].UnixTime {
+			t.Errorf("expected record %d to be after record %d: %+v, %+v", i, i-1, events[i], events[i-1])
+		}
+	}
+
+	// the records get the same times whatever the number of workers, including the ones generated again
+	type Entry struct {
+		Event
+		N int `faker:"boundary_start=0, boundary_end=100,unique"`
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	generate := func(workers int) []Entry {
+		defer ResetUnique()
+		var entries []Entry
+		if err := FakeMany(&entries, 60, Monotonic(NewSequence(start, time.Minute)), Workers(workers), Seed(7)); err != nil {
+			t.Fatal(err)
+		}
+		return entries
+	}
+	single, concurrent := generate(1), generate(8)
+	if !reflect.DeepEqual(single, concurrent) {
+		t.Error("expected the same records with 1 and 8 workers")
+	}
+	for i := 1; i < len(concurrent); i++ {
+		if concurrent[i].UnixTime <= concurrent[i-1].UnixTime || concurrent[i].ULID <= concurrent[i-1].ULID {
+			t.Errorf("expected record %d to be after record %d: %+v, %+v", i, i-1, concurrent[i], concurrent[i-1])
+		}
+	}
+
+	// values stay distinct when the sequence is shared by concurrent goroutines
+	shared := NewSequence(time.Now(), time.Millisecond)
+	ids := make([]string, 200)
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var e Event
+			if err := FakeData(&e, Monotonic(shared)); err != nil {
+				t.Error(err)
+			}
+			ids[i] = e.ULID
+		}(i)
+	}
+	wg.Wait()
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			t.Errorf("expected distinct ULIDs, but got %s twice", id)
+		}
+		seen[id] = true
+	}
+}
@@ -0,0 +1,59 @@
+package faker
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// StreamData fakes values of the type of sample, one at a time, and sends them on the returned channel
+// until ctx is done or an error occurs. The next value is only generated once the previous one is received.
+// The channel of values is closed when the stream stops, the error channel then holds the reason, e.g.
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	users, errc := faker.StreamData(ctx, User{})
+//	for u := range users {
+//		process(u.(User))
+//	}
+//	err := <-errc
+func StreamData(ctx context.Context, sample interface{}, opt ...Option) (<-chan interface{}, <-chan error) {
+	values := make(chan interface{})
+	errc := make(chan error, 1)
+	go func() {
+		defer close(values)
+		errc <- stream(ctx, reflect.TypeOf(sample), opt, func(v reflect.Value) bool {
+			select {
+			case values <- v.Interface():
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+	return values, errc
+}
+
+// stream generates values of type t and passes them to send until the latter returns false, ctx is done
+// or an error occurs. The values share the source set with Seed, so a seeded stream is deterministic.
+func stream(ctx context.Context, t reflect.Type, opt []Option, send func(v reflect.Value) bool) error {
+	if t == nil {
+		return fmt.Errorf(ErrUnsupportedKind, reflect.Invalid)
+	}
+	r := newOptions(opt...).r
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		opts := newOptions(opt...)
+		opts.r = r
+		opts.ctx = ctx
+		v := reflect.New(t)
+		if err := fakeData(v.Interface(), opts); err != nil {
+			return err
+		}
+		if !send(v.Elem()) {
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,29 @@
+//go:build go1.18
+// +build go1.18
+
+package faker
+
+import (
+	"context"
+	"reflect"
+)
+
+// Stream is StreamData for values of type T, e.g.
+//
+//	users, errc := faker.Stream[User](ctx, faker.Seed(42))
+func Stream[T any](ctx context.Context, opt ...Option) (<-chan T, <-chan error) {
+	values := make(chan T)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(values)
+		errc <- stream(ctx, reflect.TypeOf((*T)(nil)).Elem(), opt, func(v reflect.Value) bool {
+			select {
+			case values <- v.Interface().(T):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+	return values, errc
+}
@@ -0,0 +1,21 @@
+//go:build go1.18
+// +build go1.18
+
+package faker
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	records, _ := Stream[*ManyRecord](ctx)
+	for i := 0; i < 3; i++ {
+		if record := <-records; record == nil || record.Email == "" {
+			t.Errorf("expected a faked record, but got %v", record)
+		}
+	}
+}
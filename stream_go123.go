@@ -0,0 +1,33 @@
+//go:build go1.23
+// +build go1.23
+
+package faker
+
+import (
+	"context"
+	"iter"
+	"reflect"
+)
+
+// Values returns an iterator over fake values of type T, generated as they are requested until ctx is done.
+// An error ends the iteration, after being yielded with the zero value of T, e.g.
+//
+//	for u, err := range faker.Values[User](ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		process(u)
+//	}
+func Values[T any](ctx context.Context, opt ...Option) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stopped := false
+		err := stream(ctx, reflect.TypeOf((*T)(nil)).Elem(), opt, func(v reflect.Value) bool {
+			stopped = !yield(v.Interface().(T), nil)
+			return !stopped
+		})
+		if err != nil && !stopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
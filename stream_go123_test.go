@@ -0,0 +1,33 @@
+//go:build go1.23
+// +build go1.23
+
+package faker
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	count := 0
+	for record, err := range Values[ManyRecord](context.Background(), Seed(1)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record.Email == "" {
+			t.Errorf("expected a faked record, but got %v", record)
+		}
+		count++
+		if count == 5 {
+			break
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, err := range Values[ManyRecord](ctx) {
+		if err != context.Canceled {
+			t.Errorf("expected context canceled, but got %v", err)
+		}
+	}
+}
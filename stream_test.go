@@ -0,0 +1,60 @@
+package faker
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestStreamData(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	values, errc := StreamData(ctx, ManyRecord{}, Seed(5))
+
+	var records []ManyRecord
+	for v := range values {
+		records = append(records, v.(ManyRecord))
+		if len(records) == 10 {
+			cancel()
+			break
+		}
+	}
+	for range values {
+		// drain the value generated while cancelling
+	}
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("expected context canceled, but got %v", err)
+	}
+
+	var expected []ManyRecord
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	values, _ = StreamData(ctx, ManyRecord{}, Seed(5))
+	for len(expected) < 10 {
+		expected = append(expected, (<-values).(ManyRecord))
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Error("expected the same values from the same seed")
+	}
+	if reflect.DeepEqual(records[0], records[1]) {
+		t.Error("expected distinct values in a stream")
+	}
+}
+
+func TestStreamDataError(t *testing.T) {
+	type Sample struct {
+		Value string `faker:"unknown"`
+	}
+	values, errc := StreamData(context.Background(), Sample{})
+	for range values {
+		t.Error("expected no value")
+	}
+	if err := <-errc; err == nil || err.Error() != fmt.Sprintf(ErrTagNotSupported, "unknown") {
+		t.Errorf("expected unsupported tag, but got %v", err)
+	}
+
+	_, errc = StreamData(context.Background(), nil)
+	if err := <-errc; err == nil {
+		t.Error("expected error on nil sample, but got nil")
+	}
+}
@@ -0,0 +1,647 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// bloomFilter holds the sizing of the bloom filters used to track unique values, zero when exact tracking is used
+var bloomFilter = bloomSizing{}
+
+type bloomSizing struct {
+	n    int
+	rate float64
+}
+
+// uniqueSet remembers the values generated for a unique scope
+type uniqueSet interface {
+	contains(v interface{}) bool
+	add(v interface{})
+	len() int
+}
+
+// SetUniqueBloomFilter tracks unique values with bloom filters sized for n values per scope with the given
+// false positive rate, instead of keeping every value in memory. A false positive only costs a retry, a value is
+// never generated twice, but generation fails earlier than with exact tracking once a scope holds more than n values.
+// It applies to the scopes created afterwards, call ResetUnique to apply it to all of them.
+// Use n = 0 to go back to exact tracking.
+func SetUniqueBloomFilter(n int, falsePositiveRate float64) error {
+	if n < 0 {
+		return fmt.Errorf(ErrSmallerThanZero, n)
+	}
+	if n == 0 {
+		settingsMu.Lock()
+		defer settingsMu.Unlock()
+
+		bloomFilter = bloomSizing{}
+		return nil
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return errors.New(ErrWrongFalsePositiveRate)
+	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
+	bloomFilter = bloomSizing{n: n, rate: falsePositiveRate}
+	return nil
+}
+
+func newUniqueSet() uniqueSet {
+	settingsMu.RLock()
+	sizing := bloomFilter
+	settingsMu.RUnlock()
+
+	if sizing.n > 0 {
+		return newBloomSet(sizing.n, sizing.rate)
+	}
+	return hashSet{}
+}
+
+// Unique calls fn until it returns a value that was never returned in scope and returns it.
+// Unlike the single fake data functions with SetGenerateUniqueValues, it returns an error instead of panicking
+// when no unique value can be found. When scope is a tag, e.g. faker.DayOfWeekTag, it fails as soon as
+// all the possible values of the tag were generated.
+//
+//	day, err := faker.Unique(faker.DayOfWeekTag, func() interface{} { return faker.DayOfWeek() })
+func Unique(scope string, fn func() interface{}) (interface{}, error) {
+	n, bounded := domainSize(scope, nil, provider{})
+	maxRetry := retryBudget()
+	for i := 0; i < maxRetry; i++ {
+		value := fn()
+		if insertUnique(scope, value) { // Retry if unique value already found
+			return value, nil
+		}
+		if bounded && uniqueLen(scope) >= n {
+			return nil, fmt.Errorf(ErrUniqueExhausted, n, scope)
+		}
+	}
+	return nil, fmt.Errorf(ErrUniqueFailure, scope)
+}
+
+// tagDomains returns the number of distinct values of the tags generating a small set of values
+var tagDomains = map[string]func(p provider) int{
+	TitleMaleTag:         func(p provider) int { return distinct(p.dataset(titlesMaleDataset)) },
+	TitleFemaleTag:       func(p provider) int { return distinct(p.dataset(titlesFemaleDataset)) },
+	FirstNameTag:         func(p provider) int { return distinct(p.dataset(firstNamesDataset)) },
+	FirstNameMaleTag:     func(p provider) int { return distinct(p.dataset(firstNamesMaleDataset)) },
+	FirstNameFemaleTag:   func(p provider) int { return distinct(p.dataset(firstNamesFemaleDataset)) },
+	LastNameTag:          func(p provider) int { return distinct(p.dataset(lastNamesDataset)) },
+	MonthNameTag:         func(provider) int { return 12 },
+	DayOfWeekTag:         func(provider) int { return 7 },
+	DayOfMonthTag:        func(provider) int { return 31 },
+	CENTURY:              func(provider) int { return distinct(century) },
+	TIMEZONE:             func(provider) int { return distinct(timezones) },
+	TimePeriodTag:        func(provider) int { return 2 },
+	WORD:                 func(p provider) int { return distinct(p.dataset(wordsDataset)) },
+	CurrencyTag:          func(p provider) int { return distinct(p.dataset(currenciesDataset)) },
+	CreditCardType:       func(provider) int { return len(creditCards) },
+	StreetNameTag:        func(p provider) int { return distinct(p.dataset(streetNamesDataset)) },
+	CityTag:              func(p provider) int { return distinctPlaces(p, func(pl place) string { return pl.city }) },
+	StateTag:             func(p provider) int { return distinctPlaces(p, func(pl place) string { return pl.state }) },
+	StateAbbrTag:         func(p provider) int { return distinctPlaces(p, func(pl place) string { return pl.stateCode }) },
+	CountryTag:           func(provider) int { return len(countries) },
+	CountryCodeTag:       func(provider) int { return len(countries) },
+	CountryCodeAlpha3Tag: func(provider) int { return len(countries) },
+}
+
+// distinctPlaces returns the number of distinct values of the given part of the places of the locale of p
+func distinctPlaces(p provider, part func(place) string) int {
+	_, places := Address{p}.places()
+	values := make([]string, len(places))
+	for i, pl := range places {
+		values[i] = part(pl)
+	}
+	return distinct(values)
+}
+
+// domainSize estimates how many distinct values can be generated with tag for a value of type t, t can be nil,
+// in the locale of p.
+// It returns false when there are too many of them or when they can't be counted.
+func domainSize(tag string, t reflect.Type, p provider) (int, bool) {
+	if fn, ok := tagDomains[tag]; ok {
+		return fn(p), true
+	}
+	switch {
+	case strings.HasPrefix(tag, Use+Equals):
+		return 1, true
+	case tag == DatasetTag:
+		name, ok := p.param(DatasetTag)
+		return distinct(p.dataset(name)), ok
+	case strings.Contains(tag, BoundaryStart) && strings.Contains(tag, BoundaryEnd):
+		boundaries := strings.Split(tag, comma)
+		if len(boundaries) != 2 {
+			return 0, false
+		}
+		start, err := extractNumberFromText(boundaries[0])
+		if err != nil {
+			return 0, false
+		}
+		end, err := extractNumberFromText(boundaries[1])
+		if err != nil {
+			return 0, false
+		}
+		return int(end - start), true
+	case tag != "" || t == nil:
+		return 0, false
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	boundary := numberBoundaries()
+	switch t.Kind() {
+	case reflect.Bool:
+		return 2, true
+	case reflect.Int8, reflect.Uint8:
+		if n := boundary.end - boundary.start; n < 256 {
+			return n, true
+		}
+		return 256, true
+	case reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return boundary.end - boundary.start, true
+	}
+	return 0, false
+}
+
+func distinct(s []string) int {
+	set := make(map[string]struct{}, len(s))
+	for _, item := range s {
+		set[item] = struct{}{}
+	}
+	return len(set)
+}
+
+// insertUnique adds v to the unique values of scope, it returns false if v was already generated
+func insertUnique(scope string, v interface{}) bool {
+	return insertUniqueAll([]string{scope}, []interface{}{v}) < 0
+}
+
+// insertUniqueAll adds each value to the unique values of the scope with the same index, all or none of them:
+// it returns the index of the first value that was already generated, or -1 once they are all added
+func insertUniqueAll(scopes []string, values []interface{}) int {
+	uniqueMu.Lock()
+	defer uniqueMu.Unlock()
+
+	for i, scope := range scopes {
+		if set, ok := uniqueValues[scope]; ok && set.contains(values[i]) {
+			return i
+		}
+	}
+	for i, scope := range scopes {
+		set, ok := uniqueValues[scope]
+		if !ok {
+			set = newUniqueSet()
+			uniqueValues[scope] = set
+		}
+		set.add(values[i])
+	}
+	return -1
+}
+
+// containsUnique tells if v was already generated in scope
+func containsUnique(scope string, v interface{}) bool {
+	uniqueMu.Lock()
+	defer uniqueMu.Unlock()
+
+	set, ok := uniqueValues[scope]
+	return ok && set.contains(v)
+}
+
+// uniqueClaim is a unique value generated by a call deferring its insertion
+type uniqueClaim struct {
+	scope string
+	value interface{}
+}
+
+// claimUnique adds v to the unique values of scope for the call, it returns false if v was already generated
+func (o *options) claimUnique(scope string, v interface{}) bool {
+	return o.claimUniqueAll([]string{scope}, []interface{}{v}) < 0
+}
+
+// claimUniqueAll is insertUniqueAll for the call. When the call defers its unique values, they are only checked
+// against the values already inserted and the ones claimed by the call, and kept in its claims.
+func (o *options) claimUniqueAll(scopes []string, values []interface{}) int {
+	if !o.deferUnique {
+		return insertUniqueAll(scopes, values)
+	}
+	for i, scope := range scopes {
+		if containsUnique(scope, values[i]) || o.claimed(scope, values[i]) {
+			return i
+		}
+	}
+	for i, scope := range scopes {
+		o.claims = append(o.claims, uniqueClaim{scope: scope, value: values[i]})
+	}
+	return -1
+}
+
+// claimField is claimUnique for the i-th field of the struct being generated. When the struct has unique_together
+// groups, v is checked against the values already generated and the ones held for the other fields, and held for
+// the field instead of claimed.
+func (o *options) claimField(i int, scope string, v interface{}) bool {
+	if o.held == nil {
+		return o.claimUnique(scope, v)
+	}
+	if containsUnique(scope, v) || o.claimed(scope, v) {
+		return false
+	}
+	key := setKey(v)
+	for j, claim := range o.held {
+		if j != i && claim.scope == scope && setKey(claim.value) == key {
+			return false
+		}
+	}
+	o.held[i] = uniqueClaim{scope: scope, value: v}
+	return true
+}
+
+func (o *options) claimed(scope string, v interface{}) bool {
+	key := setKey(v)
+	for _, claim := range o.claims {
+		if claim.scope == scope && setKey(claim.value) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// uniqueLen returns how many values were generated in scope
+func uniqueLen(scope string) int {
+	uniqueMu.Lock()
+	defer uniqueMu.Unlock()
+
+	if set, ok := uniqueValues[scope]; ok {
+		return set.len()
+	}
+	return 0
+}
+
+// hashedValue stands for a value that can't be a map key, it is the hash of its content
+type hashedValue uint64
+
+// hashSet is an exact uniqueSet
+type hashSet map[interface{}]struct{}
+
+func (s hashSet) contains(v interface{}) bool {
+	_, ok := s[setKey(v)]
+	return ok
+}
+
+func (s hashSet) add(v interface{}) {
+	s[setKey(v)] = struct{}{}
+}
+
+func (s hashSet) len() int {
+	return len(s)
+}
+
+// setKey returns v itself when it can be used as a map key, the hash of its content otherwise
+func setKey(v interface{}) interface{} {
+	if v == nil || comparableType(reflect.TypeOf(v)) {
+		return v
+	}
+	return hashedValue(hashOf(v))
+}
+
+// comparableType tells if all the values of type t can be used as map keys and are equal when their content is.
+// Interfaces are not, as the value they hold might not be, and neither are pointers, which compare by address.
+func comparableType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Func, reflect.Interface, reflect.Ptr:
+		return false
+	case reflect.Array:
+		return comparableType(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !comparableType(t.Field(i).Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// hashOf returns a hash of the type and the content of v, maps are hashed with their keys sorted
+// and pointers with the values they point to
+func hashOf(v interface{}) uint64 {
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%T:", v)
+	writeContent(h, reflect.ValueOf(v), map[uintptr]bool{})
+	return h.Sum64()
+}
+
+// writeContent writes the content of v to w, going through the pointers it holds. seen holds the pointers being
+// written, to stop on cycles.
+func writeContent(w io.Writer, v reflect.Value, seen map[uintptr]bool) {
+	if !v.IsValid() {
+		_, _ = io.WriteString(w, "nil")
+		return
+	}
+	if v.Type() == timeType && v.CanInterface() {
+		// without the monotonic clock reading, which equal times don't share
+		_, _ = io.WriteString(w, v.Interface().(time.Time).Round(0).String())
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || seen[v.Pointer()] {
+			_, _ = io.WriteString(w, "nil")
+			return
+		}
+		seen[v.Pointer()] = true
+		defer delete(seen, v.Pointer())
+		_, _ = io.WriteString(w, "&")
+		writeContent(w, v.Elem(), seen)
+	case reflect.Interface:
+		if !v.IsNil() {
+			_, _ = fmt.Fprintf(w, "%s:", v.Elem().Type())
+		}
+		writeContent(w, v.Elem(), seen)
+	case reflect.Struct:
+		_, _ = io.WriteString(w, "{")
+		for i := 0; i < v.NumField(); i++ {
+			writeContent(w, v.Field(i), seen)
+			_, _ = io.WriteString(w, ",")
+		}
+		_, _ = io.WriteString(w, "}")
+	case reflect.Slice, reflect.Array:
+		_, _ = io.WriteString(w, "[")
+		for i := 0; i < v.Len(); i++ {
+			writeContent(w, v.Index(i), seen)
+			_, _ = io.WriteString(w, ",")
+		}
+		_, _ = io.WriteString(w, "]")
+	case reflect.Map:
+		entries := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			var entry strings.Builder
+			writeContent(&entry, key, seen)
+			_, _ = entry.WriteString(":")
+			writeContent(&entry, v.MapIndex(key), seen)
+			entries = append(entries, entry.String())
+		}
+		sort.Strings(entries)
+		_, _ = fmt.Fprintf(w, "map%v", entries)
+	case reflect.String:
+		_, _ = fmt.Fprintf(w, "%q", v.String())
+	case reflect.Bool:
+		_, _ = fmt.Fprint(w, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, _ = fmt.Fprint(w, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		_, _ = fmt.Fprint(w, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		_, _ = fmt.Fprint(w, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		_, _ = fmt.Fprint(w, v.Complex())
+	default:
+		// functions, channels and unsafe pointers only equal themselves
+		_, _ = fmt.Fprintf(w, "%#x", v.Pointer())
+	}
+}
+
+// bloomSet is a uniqueSet using a fixed amount of memory, at the cost of false positives
+type bloomSet struct {
+	bits   []uint64
+	hashes uint64
+	count  int
+}
+
+func newBloomSet(n int, rate float64) *bloomSet {
+	m := math.Ceil(-float64(n) * math.Log(rate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/float64(n)*math.Ln2))
+	return &bloomSet{
+		bits:   make([]uint64, int(m)/64+1),
+		hashes: uint64(k),
+	}
+}
+
+// positions returns the bits used by v in the filter, using double hashing
+func (s *bloomSet) positions(v interface{}) []uint64 {
+	sum := hashOf(v)
+	h1, h2 := sum&math.MaxUint32, sum>>32|1
+	size := uint64(len(s.bits) * 64)
+	res := make([]uint64, s.hashes)
+	for i := range res {
+		res[i] = (h1 + uint64(i)*h2) % size
+	}
+	return res
+}
+
+func (s *bloomSet) contains(v interface{}) bool {
+	for _, bit := range s.positions(v) {
+		if s.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *bloomSet) add(v interface{}) {
+	for _, bit := range s.positions(v) {
+		s.bits[bit/64] |= 1 << (bit % 64)
+	}
+	s.count++
+}
+
+func (s *bloomSet) len() int {
+	return s.count
+}
+
+// uniqueGroup is a set of fields whose combination of values has to be unique
+type uniqueGroup struct {
+	name   string
+	fields []int
+}
+
+// uniqueGroups returns the groups declared on the struct type t with unique_together tags, usually on a blank field:
+//
+//	_ struct{} `faker:"unique_together=TenantID,Slug,unique_together=UserID,Date"`
+func uniqueGroups(t reflect.Type) ([]uniqueGroup, error) {
+	var groups []uniqueGroup
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get(tagName)
+		if !strings.Contains(tag, UniqueTogether+Equals) {
+			continue
+		}
+		var names [][]string
+		for _, item := range strings.Split(tag, comma) {
+			item = strings.TrimSpace(item)
+			switch {
+			case strings.HasPrefix(item, UniqueTogether+Equals):
+				names = append(names, []string{strings.TrimPrefix(item, UniqueTogether+Equals)})
+			case len(names) > 0 && !strings.Contains(item, Equals):
+				names[len(names)-1] = append(names[len(names)-1], item)
+			}
+		}
+		for _, group := range names {
+			g := uniqueGroup{name: strings.Join(group, comma)}
+			for _, name := range group {
+				field, ok := t.FieldByName(name)
+				if !ok || len(field.Index) != 1 {
+					return nil, fmt.Errorf(ErrUnknownDependency, t.Field(i).Name, name)
+				}
+				g.fields = append(g.fields, field.Index[0])
+			}
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+// key returns the combination of values of the group fields in v
+func (g uniqueGroup) key(v reflect.Value) []interface{} {
+	res := make([]interface{}, len(g.fields))
+	for i, field := range g.fields {
+		res[i] = v.Field(field).Interface()
+	}
+	return res
+}
+
+// typeScope returns the default unique scope of the field or unique_together group name of the struct type t,
+// e.g. "github.com/acme/models.User.Email", whose package path tells apart the types of packages with the same name
+func typeScope(t reflect.Type, name string) string {
+	if t.Name() == "" {
+		return t.String() + "." + name
+	}
+	return t.PkgPath() + "." + t.Name() + "." + name
+}
+
+// uniqueTogether fills the fields of the struct v with setFields, then regenerates the fields of its unique_together
+// groups, and the fields depending on them, until every group holds a combination of values that was never generated
+// before. The unique values of the fields are only claimed along with the groups, so that the values of the rejected
+// combinations are not kept. Unique derived fields are held the same way, and their sources are regenerated
+// when their value was already generated, see sources.
+func uniqueTogether(v, original reflect.Value, tags []structTag, order []int, opts *options, path string) error {
+	t := v.Type()
+	groups, err := uniqueGroups(t)
+	if err != nil {
+		return err
+	}
+	// the values of nested structs are held by their own unique_together groups, if any
+	held := opts.held
+	defer func() { opts.held = held }()
+	opts.held = nil
+	if len(groups) == 0 && !uniqueDerived(tags) {
+		return setFields(v, original, tags, order, opts, path)
+	}
+
+	opts.held = map[int]uniqueClaim{}
+	if err := setFields(v, original, tags, order, opts, path); err != nil {
+		return err
+	}
+
+	maxRetry := retryBudget()
+	for retry := 0; ; retry++ {
+		scopes := make([]string, 0, len(groups)+len(opts.held))
+		keys := make([]interface{}, 0, len(groups)+len(opts.held))
+		for _, group := range groups {
+			scopes = append(scopes, typeScope(t, group.name))
+			keys = append(keys, group.key(v))
+		}
+		fields := make([]int, 0, len(opts.held))
+		for i := range tags {
+			if claim, ok := opts.held[i]; ok {
+				scopes = append(scopes, claim.scope)
+				keys = append(keys, claim.value)
+				fields = append(fields, i)
+			}
+		}
+		seen := heldTwice(scopes, keys, len(groups))
+		if seen < 0 {
+			seen = opts.claimUniqueAll(scopes, keys)
+		}
+		if seen < 0 {
+			return nil
+		}
+		var name string
+		var dirty []int
+		if seen < len(groups) {
+			name, dirty = groups[seen].name, groups[seen].fields
+		} else {
+			// the value of a field was generated by another call since it was held
+			field := fields[seen-len(groups)]
+			name, dirty = t.Field(field).Name, []int{field}
+			if tags[field].derived() {
+				dirty = sources(t, tags, field)
+			}
+		}
+		if retry >= maxRetry {
+			return fmt.Errorf(ErrUniqueFailure, name)
+		}
+		if err := setFields(v, original, tags, dependents(t, tags, order, dirty), opts, path); err != nil {
+			return err
+		}
+	}
+}
+
+// uniqueDerived tells if one of the fields is unique and derived from sibling fields
+func uniqueDerived(tags []structTag) bool {
+	for _, tag := range tags {
+		if tag.unique && tag.derived() {
+			return true
+		}
+	}
+	return false
+}
+
+// heldTwice returns the index of the first held value, from start on, equal to a value held before it in the same
+// scope, or -1 when there is none. Derived fields are held without being checked against the other held values.
+func heldTwice(scopes []string, values []interface{}, start int) int {
+	for i := start; i < len(values); i++ {
+		key := setKey(values[i])
+		for j := start; j < i; j++ {
+			if scopes[j] == scopes[i] && setKey(values[j]) == key {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// sources returns the fields the derived field i is computed from, directly or through other derived fields.
+// They are regenerated, along with the fields depending on them, to change the value of the field.
+func sources(t reflect.Type, tags []structTag, i int) []int {
+	var res []int
+	seen := map[int]bool{}
+	pending := []int{i}
+	for len(pending) > 0 {
+		i, pending = pending[0], pending[1:]
+		for _, name := range tags[i].dependencies() {
+			if field, ok := t.FieldByName(name); ok && !seen[field.Index[0]] {
+				seen[field.Index[0]] = true
+				res = append(res, field.Index[0])
+				pending = append(pending, field.Index[0])
+			}
+		}
+	}
+	return res
+}
+
+// dependents returns, in generation order, the given fields and all the fields depending on them
+func dependents(t reflect.Type, tags []structTag, order []int, fields []int) []int {
+	dirty := make(map[int]bool, len(fields))
+	for _, i := range fields {
+		dirty[i] = true
+	}
+	res := make([]int, 0, len(order))
+	for _, i := range order {
+		for _, name := range tags[i].dependencies() {
+			if field, ok := t.FieldByName(name); ok && dirty[field.Index[0]] {
+				dirty[i] = true
+			}
+		}
+		if dirty[i] {
+			res = append(res, i)
+		}
+	}
+	return res
+}
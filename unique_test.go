@@ -0,0 +1,267 @@
+package faker
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"reflect"
+	"testing"
+	texttemplate "text/template"
+	"time"
+)
+
+func TestHashSet(t *testing.T) {
+	set := hashSet{}
+	values := []interface{}{"a", 1, int64(1), []string{"a"}, map[string]int{"a": 1, "b": 2}, struct{ V interface{} }{[]int{1}}}
+	for _, v := range values {
+		if set.contains(v) {
+			t.Errorf("expected %#v not to be found", v)
+		}
+		set.add(v)
+	}
+	duplicates := []interface{}{"a", 1, []string{"a"}, map[string]int{"b": 2, "a": 1}, struct{ V interface{} }{[]int{1}}}
+	for _, v := range duplicates {
+		if !set.contains(v) {
+			t.Errorf("expected %#v to be found", v)
+		}
+	}
+	if set.len() != len(values) {
+		t.Errorf("expected %d values, but got %d", len(values), set.len())
+	}
+}
+
+func TestHashSetPointers(t *testing.T) {
+	set := hashSet{}
+	a, b := "a", "a"
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	set.add(&a)
+	set.add(struct{ Day *time.Time }{&day})
+	later := day
+	if !set.contains(&b) || !set.contains(struct{ Day *time.Time }{&later}) {
+		t.Error("expected pointers to be compared by the values they point to")
+	}
+	if set.contains((*string)(nil)) || set.len() != 2 {
+		t.Errorf("expected 2 values, but got %d", set.len())
+	}
+}
+
+func TestUniquePointers(t *testing.T) {
+	type Sample struct {
+		Day *string `faker:"day_of_week,unique"`
+	}
+	defer ResetUnique()
+
+	seen := map[string]bool{}
+	for i := 0; i < 7; i++ {
+		s := Sample{}
+		if err := FakeData(&s); err != nil {
+			t.Fatal(err)
+		}
+		if seen[*s.Day] {
+			t.Errorf("expected unique days, but got %s twice", *s.Day)
+		}
+		seen[*s.Day] = true
+	}
+	if err := FakeData(&Sample{}); err == nil {
+		t.Error("expected an error once all the days were generated, but got nil")
+	}
+}
+
+func TestBloomSet(t *testing.T) {
+	set := newBloomSet(1000, 0.01)
+	inserted := 0
+	for i := 0; i < 1000; i++ {
+		if !set.contains(i) {
+			set.add(i)
+			inserted++
+		}
+	}
+	if inserted < 950 {
+		t.Errorf("expected a false positive rate close to 1%%, but only %d values out of 1000 were inserted", inserted)
+	}
+	for i := 0; i < 1000; i++ {
+		if !set.contains(i) {
+			t.Errorf("expected %d to be found", i)
+		}
+	}
+	if set.len() != inserted {
+		t.Errorf("expected %d values, but got %d", inserted, set.len())
+	}
+}
+
+func TestSetUniqueBloomFilter(t *testing.T) {
+	if err := SetUniqueBloomFilter(-1, 0.01); err == nil {
+		t.Error("expected error on negative size, but got nil")
+	}
+	if err := SetUniqueBloomFilter(100, 1); err == nil {
+		t.Error("expected error on wrong false positive rate, but got nil")
+	}
+	if err := SetUniqueBloomFilter(100, 0.01); err != nil {
+		t.Fatal("Expected NoError, but Got Err: ", err)
+	}
+	defer func() {
+		_ = SetUniqueBloomFilter(0, 0)
+		ResetUnique()
+	}()
+
+	type Sample struct {
+		Email string `faker:"email,unique"`
+	}
+	for i := 0; i < 50; i++ {
+		if err := FakeData(&Sample{}); err != nil {
+			t.Fatal("can't fake the unique data", err)
+		}
+	}
+	if _, ok := uniqueValues["github.com/togglhire/faker/v3.Sample.Email"].(*bloomSet); !ok {
+		t.Errorf("expected a bloom filter, but got %T", uniqueValues["github.com/togglhire/faker/v3.Sample.Email"])
+	}
+}
+
+func TestTypeScope(t *testing.T) {
+	html := typeScope(reflect.TypeOf(htmltemplate.Template{}), "Field")
+	text := typeScope(reflect.TypeOf(texttemplate.Template{}), "Field")
+	if html != "html/template.Template.Field" || text != "text/template.Template.Field" {
+		t.Errorf("expected scopes telling the packages apart, but got %s and %s", html, text)
+	}
+}
+
+func TestUniqueTogether(t *testing.T) {
+	type Page struct {
+		_        struct{} `faker:"unique_together=TenantID,Slug"`
+		TenantID int      `faker:"boundary_start=1, boundary_end=3"`
+		Slug     string   `faker:"day_of_week"`
+		Title    string   `faker:"from=Slug"`
+	}
+	defer ResetUnique()
+
+	seen := map[Page]bool{}
+	for i := 0; i < 14; i++ {
+		p := Page{}
+		if err := FakeData(&p); err != nil {
+			t.Fatal("can't fake the unique data", err)
+		}
+		key := Page{TenantID: p.TenantID, Slug: p.Slug}
+		if seen[key] {
+			t.Errorf("expected unique combinations, found %+v twice", key)
+		}
+		seen[key] = true
+		if p.Title != p.Slug {
+			t.Errorf("expected the dependent field to be regenerated, got %+v", p)
+		}
+	}
+
+	err := FakeData(&Page{})
+	if err == nil || err.Error() != fmt.Sprintf(ErrUniqueFailure, "TenantID,Slug") {
+		t.Errorf("expected unique failure on the group, but got %v", err)
+	}
+}
+
+func TestUniqueTogetherHeldValues(t *testing.T) {
+	type Page struct {
+		_        struct{} `faker:"unique_together=TenantID,Slug"`
+		TenantID int      `faker:"boundary_start=1, boundary_end=3"`
+		Slug     string   `faker:"day_of_week,unique=slugs"`
+	}
+	defer ResetUnique()
+
+	for i := 0; i < 14; i++ {
+		ResetUnique("slugs")
+		if err := FakeData(&Page{}); err != nil {
+			t.Fatal("can't fake the unique data", err)
+		}
+		// the slugs of the rejected combinations are not kept
+		if n := uniqueLen("slugs"); n != 1 {
+			t.Fatalf("expected a single slug, but got %d", n)
+		}
+	}
+}
+
+func TestUniqueTogetherUnknownField(t *testing.T) {
+	type Page struct {
+		_    struct{} `faker:"unique_together=TenantID,Missing"`
+		Slug string
+	}
+	if err := FakeData(&Page{}); err == nil {
+		t.Error("expected error on unknown field, but got nil")
+	}
+}
+
+func TestUniqueExhausted(t *testing.T) {
+	type Sample struct {
+		Flag bool   `faker:"unique"`
+		Day  string `faker:"day_of_week,unique"`
+	}
+	defer ResetUnique()
+
+	if err := FakeData(&Sample{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := FakeData(&Sample{}); err != nil {
+		t.Fatal(err)
+	}
+	err := FakeData(&Sample{})
+	if err == nil || err.Error() != fmt.Sprintf(ErrUniqueExhausted, 2, "Flag") {
+		t.Errorf("expected exhausted error on Flag, but got %v", err)
+	}
+}
+
+func TestUniqueFunc(t *testing.T) {
+	defer ResetUnique()
+
+	days := map[interface{}]bool{}
+	for i := 0; i < 7; i++ {
+		day, err := Unique(DayOfWeekTag, func() interface{} { return DayOfWeek() })
+		if err != nil {
+			t.Fatal(err)
+		}
+		days[day] = true
+	}
+	if len(days) != 7 {
+		t.Errorf("expected 7 distinct days, but got %d", len(days))
+	}
+	_, err := Unique(DayOfWeekTag, func() interface{} { return DayOfWeek() })
+	if err == nil || err.Error() != fmt.Sprintf(ErrUniqueExhausted, 7, DayOfWeekTag) {
+		t.Errorf("expected exhausted error, but got %v", err)
+	}
+
+	_, err = Unique("constant", func() interface{} { return 1 })
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Unique("constant", func() interface{} { return 1 })
+	if err == nil || err.Error() != fmt.Sprintf(ErrUniqueFailure, "constant") {
+		t.Errorf("expected unique failure, but got %v", err)
+	}
+}
+
+func TestSetMaxRetry(t *testing.T) {
+	defer func() { _ = SetMaxRetry(10000) }()
+
+	if err := SetMaxRetry(0); err == nil {
+		t.Error("expected error on zero retry, but got nil")
+	}
+	if err := SetMaxRetry(3); err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	_, _ = Unique("retry", func() interface{} { return 1 })
+	_, err := Unique("retry", func() interface{} {
+		calls++
+		return 1
+	})
+	ResetUnique("retry")
+	if err == nil || calls != 3 {
+		t.Errorf("expected 3 calls and an error, but got %d calls and %v", calls, err)
+	}
+}
+
+func BenchmarkUniqueEmails(b *testing.B) {
+	type Sample struct {
+		Email string `faker:"email,unique"`
+	}
+	for i := 0; i < b.N; i++ {
+		if err := FakeData(&Sample{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+	ResetUnique()
+}
@@ -1,10 +1,18 @@
 package faker
 
 import (
+	"crypto/md5"
 	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"reflect"
+	"strings"
+	"time"
 )
 
 var identifier Identifier
@@ -26,13 +34,39 @@ type Identifier interface {
 	Hyphenated(v reflect.Value) (interface{}, error)
 }
 
+// uuidNamespaces are the namespaces of the name based UUIDs defined in RFC 4122, appendix C
+var uuidNamespaces = map[string][]byte{
+	// 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+	"dns": {0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+	// 6ba7b811-9dad-11d1-80b4-00c04fd430c8
+	"url": {0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+	// 6ba7b812-9dad-11d1-80b4-00c04fd430c8
+	"oid": {0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+	// 6ba7b814-9dad-11d1-80b4-00c04fd430c8
+	"x500": {0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+}
+
+// gregorianOffset is the number of 100 nanoseconds intervals between the start of the Gregorian calendar,
+// which the timestamps of the UUIDs v1 and v6 count from, and the Unix epoch
+const gregorianOffset = 0x01b21dd213814000
+
 // UUID struct
-type UUID struct{}
+type UUID struct {
+	provider
+}
 
-// createUUID returns a 16 byte slice with random values
-func createUUID() ([]byte, error) {
+// entropy returns the source of the random bytes of the UUIDs, crypto/rand unless the generation has its own source
+func (u UUID) entropy() io.Reader {
+	if u.r != nil {
+		return u.r
+	}
+	return rand.Reader
+}
+
+// createUUID returns a 16 byte slice with random values read from entropy
+func createUUID(entropy io.Reader) ([]byte, error) {
 	b := make([]byte, 16)
-	_, err := io.ReadFull(rand.Reader, b)
+	_, err := io.ReadFull(entropy, b)
 	if err != nil {
 		return b, err
 	}
@@ -43,18 +77,57 @@ func createUUID() ([]byte, error) {
 	return b, nil
 }
 
+// random returns n bytes read from the entropy of the generation
+func (u UUID) random(n int) ([]byte, error) {
+	b := make([]byte, n)
+	_, err := io.ReadFull(u.entropy(), b)
+	return b, err
+}
+
+// setVersion sets the version and the RFC 4122 variant of the UUID b
+func setVersion(b []byte, version byte) {
+	b[6] = b[6]&^0xf0 | version<<4
+	b[8] = b[8]&^0xc0 | 0x80
+}
+
+func formatUUID(b []byte) string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func parseUUID(s string) ([]byte, bool) {
+	b, err := hex.DecodeString(strings.Replace(s, "-", "", -1))
+	return b, err == nil && len(b) == 16
+}
+
+// idValue returns the bytes of an identifier to the byte arrays of their size, e.g. [16]byte and the UUID types of
+// the UUID libraries, and its string to the other fields
+func idValue(v reflect.Value, b []byte, s string) (interface{}, error) {
+	if !v.IsValid() || v.Kind() == reflect.String {
+		return s, nil
+	}
+	if v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8 && v.Len() == len(b) {
+		array := reflect.New(v.Type()).Elem()
+		reflect.Copy(array, reflect.ValueOf(b))
+		return array.Interface(), nil
+	}
+	return nil, errors.New(ErrNotSupportedTypeForTag)
+}
+
 func (u UUID) hyphenated() (string, error) {
-	b, err := createUUID()
+	b, err := createUUID(u.entropy())
 	if err != nil {
 		return "", err
 	}
-	uuid := fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
-	return uuid, err
+	return formatUUID(b), err
 }
 
-// Hyphenated returns a 36 byte hyphenated UUID
+// Hyphenated returns a 36 byte hyphenated UUID, or its bytes to [16]byte fields
 func (u UUID) Hyphenated(v reflect.Value) (interface{}, error) {
-	return u.hyphenated()
+	b, err := createUUID(u.entropy())
+	if err != nil {
+		return nil, err
+	}
+	return idValue(v, b, formatUUID(b))
 }
 
 // UUIDHyphenated get fake Hyphenated UUID
@@ -67,7 +140,7 @@ func UUIDHyphenated() string {
 }
 
 func (u UUID) digit() (string, error) {
-	b, err := createUUID()
+	b, err := createUUID(u.entropy())
 	if err != nil {
 		return "", err
 	}
@@ -75,9 +148,13 @@ func (u UUID) digit() (string, error) {
 	return uuid, err
 }
 
-// Digit returns a 32 bytes UUID
+// Digit returns a 32 bytes UUID, or its bytes to [16]byte fields
 func (u UUID) Digit(v reflect.Value) (interface{}, error) {
-	return u.digit()
+	b, err := createUUID(u.entropy())
+	if err != nil {
+		return nil, err
+	}
+	return idValue(v, b, fmt.Sprintf("%x", b))
 }
 
 // UUIDDigit get fake Digit UUID
@@ -88,3 +165,166 @@ func UUIDDigit() string {
 		return res
 	}).(string)
 }
+
+// timeUUID returns a UUID v1, or a UUID v6 whose timestamp is written from its most significant bits,
+// with a random clock sequence and a random node, as allowed by RFC 4122, section 4.5
+func (u UUID) timeUUID(version byte) ([]byte, error) {
+	b, err := u.random(16)
+	if err != nil {
+		return nil, err
+	}
+	ts := uint64(u.now(100*time.Nanosecond).UnixNano()/100) + gregorianOffset
+	if version == 1 {
+		binary.BigEndian.PutUint32(b[0:], uint32(ts))
+		binary.BigEndian.PutUint16(b[4:], uint16(ts>>32))
+		binary.BigEndian.PutUint16(b[6:], uint16(ts>>48))
+	} else {
+		binary.BigEndian.PutUint32(b[0:], uint32(ts>>28))
+		binary.BigEndian.PutUint16(b[4:], uint16(ts>>12))
+		binary.BigEndian.PutUint16(b[6:], uint16(ts&0xfff))
+	}
+	setVersion(b, version)
+	// multicast bit of the random nodes
+	b[10] |= 0x01
+	return b, nil
+}
+
+// nameUUIDParams returns the namespace and the name set with the namespace and name tag parameters.
+// The namespace is dns, url, oid, x500 or a UUID, dns by default, and the name is a random domain name by default.
+func (u UUID) nameUUIDParams() ([]byte, string, error) {
+	ns := uuidNamespaces["dns"]
+	if namespace, ok := u.param(NamespaceParam); ok {
+		if ns, ok = uuidNamespaces[strings.ToLower(namespace)]; !ok {
+			if ns, ok = parseUUID(namespace); !ok {
+				return nil, "", fmt.Errorf(ErrWrongFormattedTag, NamespaceParam+Equals+namespace)
+			}
+		}
+	}
+	name, ok := u.param(NameParam)
+	if !ok {
+		name = Internet{u.provider}.domainName()
+	}
+	return ns, name, nil
+}
+
+// nameUUID returns a UUID v3, hashed with MD5, or v5, hashed with SHA-1, of the namespace ns and name
+func nameUUID(version byte, ns []byte, name string) []byte {
+	var h hash.Hash
+	if version == 3 {
+		h = md5.New()
+	} else {
+		h = sha1.New()
+	}
+	h.Write(ns)
+	h.Write([]byte(name))
+	b := h.Sum(nil)[:16]
+	setVersion(b, version)
+	return b
+}
+
+// uuidV7 returns a UUID v7: a Unix timestamp in milliseconds followed by random bits
+func (u UUID) uuidV7() ([]byte, error) {
+	b, err := u.random(16)
+	if err != nil {
+		return nil, err
+	}
+	ms := uint64(u.now(time.Millisecond).UnixNano() / int64(time.Millisecond))
+	binary.BigEndian.PutUint16(b[0:], uint16(ms>>32))
+	binary.BigEndian.PutUint32(b[2:], uint32(ms))
+	setVersion(b, 7)
+	return b, nil
+}
+
+// uuidString returns the hyphenated form of the UUID, an empty string when its random bytes could not be read
+func uuidString(b []byte, err error) string {
+	if err != nil {
+		return ""
+	}
+	return formatUUID(b)
+}
+
+func uuidValue(v reflect.Value, b []byte, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	return idValue(v, b, formatUUID(b))
+}
+
+// UUIDv1 returns a hyphenated time based UUID v1 with a random node, or its bytes to [16]byte fields
+func (u UUID) UUIDv1(v reflect.Value) (interface{}, error) {
+	b, err := u.timeUUID(1)
+	return uuidValue(v, b, err)
+}
+
+// UUIDv1 get fake UUID v1
+func UUIDv1() string {
+	return singleFakeData(UUIDV1Tag, func() interface{} {
+		u := UUID{}
+		return uuidString(u.timeUUID(1))
+	}).(string)
+}
+
+// UUIDv3 returns a hyphenated name based UUID v3, or its bytes to [16]byte fields,
+// e.g. `faker:"uuid_v3,namespace=url,name=https://example.com"`
+func (u UUID) UUIDv3(v reflect.Value) (interface{}, error) {
+	ns, name, err := u.nameUUIDParams()
+	if err != nil {
+		return nil, err
+	}
+	b := nameUUID(3, ns, name)
+	return idValue(v, b, formatUUID(b))
+}
+
+// UUIDv3 get fake UUID v3 of a random domain name
+func UUIDv3() string {
+	return singleFakeData(UUIDV3Tag, func() interface{} {
+		u := UUID{}
+		return formatUUID(nameUUID(3, uuidNamespaces["dns"], Internet{u.provider}.domainName()))
+	}).(string)
+}
+
+// UUIDv5 returns a hyphenated name based UUID v5, or its bytes to [16]byte fields, see UUIDv3
+func (u UUID) UUIDv5(v reflect.Value) (interface{}, error) {
+	ns, name, err := u.nameUUIDParams()
+	if err != nil {
+		return nil, err
+	}
+	b := nameUUID(5, ns, name)
+	return idValue(v, b, formatUUID(b))
+}
+
+// UUIDv5 get fake UUID v5 of a random domain name
+func UUIDv5() string {
+	return singleFakeData(UUIDV5Tag, func() interface{} {
+		u := UUID{}
+		return formatUUID(nameUUID(5, uuidNamespaces["dns"], Internet{u.provider}.domainName()))
+	}).(string)
+}
+
+// UUIDv6 returns a hyphenated time ordered UUID v6 with a random node, or its bytes to [16]byte fields
+func (u UUID) UUIDv6(v reflect.Value) (interface{}, error) {
+	b, err := u.timeUUID(6)
+	return uuidValue(v, b, err)
+}
+
+// UUIDv6 get fake UUID v6
+func UUIDv6() string {
+	return singleFakeData(UUIDV6Tag, func() interface{} {
+		u := UUID{}
+		return uuidString(u.timeUUID(6))
+	}).(string)
+}
+
+// UUIDv7 returns a hyphenated time ordered UUID v7, or its bytes to [16]byte fields
+func (u UUID) UUIDv7(v reflect.Value) (interface{}, error) {
+	b, err := u.uuidV7()
+	return uuidValue(v, b, err)
+}
+
+// UUIDv7 get fake UUID v7
+func UUIDv7() string {
+	return singleFakeData(UUIDV7Tag, func() interface{} {
+		u := UUID{}
+		return uuidString(u.uuidV7())
+	}).(string)
+}
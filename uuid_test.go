@@ -1,10 +1,12 @@
 package faker
 
 import (
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 )
 
 func TestDigit(t *testing.T) {
@@ -54,3 +56,119 @@ func TestFakeHyphenated(t *testing.T) {
 		t.Errorf("Could not match the UUID hyphenated format, err: %+v, match: %+v", err, match)
 	}
 }
+
+var uuidPattern = regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-([0-9a-f])[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+
+func TestUUIDVersions(t *testing.T) {
+	u := UUID{}
+	for version, fn := range map[string]func(reflect.Value) (interface{}, error){
+		"1": u.UUIDv1, "3": u.UUIDv3, "5": u.UUIDv5, "6": u.UUIDv6, "7": u.UUIDv7,
+	} {
+		res, err := fn(reflect.Value{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m := uuidPattern.FindStringSubmatch(res.(string)); m == nil || m[1] != version {
+			t.Errorf("expected a UUID v%s, but got %s", version, res)
+		}
+	}
+	for version, s := range map[string]string{"1": UUIDv1(), "3": UUIDv3(), "5": UUIDv5(), "6": UUIDv6(), "7": UUIDv7()} {
+		if m := uuidPattern.FindStringSubmatch(s); m == nil || m[1] != version {
+			t.Errorf("expected a UUID v%s, but got %s", version, s)
+		}
+	}
+}
+
+func TestUUIDTimestamps(t *testing.T) {
+	u := UUID{}
+	now := time.Now()
+	gregorian := func(ts uint64) time.Time {
+		return time.Unix(0, int64(ts-gregorianOffset)*100)
+	}
+	near := func(name string, got time.Time) {
+		if d := got.Sub(now); d < -time.Second || d > time.Second {
+			t.Errorf("%s: expected a timestamp close to %v, but got %v", name, now, got)
+		}
+	}
+
+	b, err := u.timeUUID(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	near("v1", gregorian(uint64(binary.BigEndian.Uint16(b[6:])&0xfff)<<48|uint64(binary.BigEndian.Uint16(b[4:]))<<32|uint64(binary.BigEndian.Uint32(b))))
+
+	b, err = u.timeUUID(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	near("v6", gregorian(uint64(binary.BigEndian.Uint32(b))<<28|uint64(binary.BigEndian.Uint16(b[4:]))<<12|uint64(binary.BigEndian.Uint16(b[6:])&0xfff)))
+
+	b, err = u.uuidV7()
+	if err != nil {
+		t.Fatal(err)
+	}
+	near("v7", time.Unix(0, int64(binary.BigEndian.Uint64(b)>>16)*int64(time.Millisecond)))
+}
+
+func TestNameUUID(t *testing.T) {
+	var r struct {
+		V3      string   `faker:"uuid_v3,namespace=dns,name=python.org"`
+		V5      string   `faker:"uuid_v5,namespace=url,name=https://example.com"`
+		Custom  string   `faker:"uuid_v5,namespace=6ba7b811-9dad-11d1-80b4-00c04fd430c8,name=https://example.com"`
+		Bytes   [16]byte `faker:"uuid_v5,namespace=url,name=https://example.com"`
+		Random  string   `faker:"uuid_v5"`
+		Random2 string   `faker:"uuid_v5"`
+	}
+	if err := FakeData(&r); err != nil {
+		t.Fatal(err)
+	}
+	if r.V3 != "6fa459ea-ee8a-3ca4-894e-db77e160355e" {
+		t.Errorf("expected the UUID v3 of python.org, but got %s", r.V3)
+	}
+	if r.V5 != "4fd35a71-71ef-5a55-a9d9-aa75c889a6d0" || r.Custom != r.V5 {
+		t.Errorf("expected the UUID v5 of https://example.com, but got %s and %s", r.V5, r.Custom)
+	}
+	if formatUUID(r.Bytes[:]) != r.V5 {
+		t.Errorf("expected the bytes of %s, but got %x", r.V5, r.Bytes)
+	}
+	if r.Random == r.Random2 {
+		t.Errorf("expected UUIDs of random names, but got %s twice", r.Random)
+	}
+
+	var wrong struct {
+		ID string `faker:"uuid_v3,namespace=example"`
+	}
+	if err := FakeData(&wrong); err == nil || err.Error() != fmt.Sprintf(ErrWrongFormattedTag, "namespace=example") {
+		t.Errorf("expected a wrong namespace error, but got %v", err)
+	}
+}
+
+// googleUUID has the type of the UUIDs of the most common UUID libraries
+type googleUUID [16]byte
+
+func TestUUIDBytes(t *testing.T) {
+	var r struct {
+		Array   [16]byte    `faker:"uuid_hyphenated"`
+		Digit   googleUUID  `faker:"uuid_digit"`
+		V7      googleUUID  `faker:"uuid_v7"`
+		Pointer *googleUUID `faker:"uuid_v1"`
+	}
+	if err := FakeData(&r); err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range [][16]byte{r.Array, r.Digit, r.V7} {
+		if !uuidPattern.MatchString(formatUUID(b[:])) {
+			t.Errorf("expected the bytes of a UUID, but got %x", b)
+		}
+	}
+	if r.Pointer == nil || r.Pointer[6]>>4 != 1 {
+		t.Errorf("expected a pointer to a UUID v1, but got %v", r.Pointer)
+	}
+
+	var wrong struct {
+		ID [8]byte `faker:"uuid_hyphenated"`
+	}
+	if err := FakeData(&wrong); err == nil {
+		t.Error("expected an error for an array of the wrong size")
+	}
+}